@@ -18,6 +18,7 @@ import (
 	distrclient "github.com/cosmos/cosmos-sdk/x/distribution/client"
 	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
 
+	marketclient "github.com/terra-project/core/x/market/client"
 	treasuryclient "github.com/terra-project/core/x/treasury/client"
 
 	"github.com/terra-project/core/x/auth"
@@ -55,7 +56,7 @@ var (
 		bank.AppModuleBasic{},
 		staking.AppModuleBasic{},
 		distr.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distrclient.ProposalHandler, treasuryclient.TaxRateUpdateProposalHandler, treasuryclient.RewardWeightUpdateProposalHandler),
+		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distrclient.ProposalHandler, treasuryclient.TaxRateUpdateProposalHandler, treasuryclient.RewardWeightUpdateProposalHandler, marketclient.CircuitBreakerProposalHandler, marketclient.DenomParamsUpdateProposalHandler),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
@@ -69,6 +70,7 @@ var (
 	maccPerms = map[string][]string{
 		auth.FeeCollectorName:     nil, // just added to enable align fee
 		market.ModuleName:         {supply.Minter, supply.Burner},
+		market.ScheduleEscrowName: nil,
 		oracle.ModuleName:         nil,
 		distr.ModuleName:          nil,
 		treasury.ModuleName:       {supply.Minter},
@@ -168,9 +170,13 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		slashingSubspace, slashing.DefaultCodespace)
 	app.crisisKeeper = crisis.NewKeeper(crisisSubspace, invCheckPeriod, app.supplyKeeper, auth.FeeCollectorName)
 	app.oracleKeeper = oracle.NewKeeper(app.cdc, keys[oracle.StoreKey], oracleSubspace, app.distrKeeper,
-		&stakingKeeper, app.supplyKeeper, distr.ModuleName, oracle.DefaultCodespace)
-	app.marketKeeper = market.NewKeeper(app.cdc, keys[market.StoreKey], marketSubspace,
-		app.oracleKeeper, app.supplyKeeper, market.DefaultCodespace)
+		&stakingKeeper, app.slashingKeeper, app.supplyKeeper, distr.ModuleName, oracle.DefaultCodespace)
+	marketKeeper := market.NewKeeper(app.cdc, keys[market.StoreKey], marketSubspace,
+		app.oracleKeeper, app.supplyKeeper, app.distrKeeper,
+		oracle.ModuleName, distr.ModuleName, market.DefaultCodespace)
+
+	// register the market hooks
+	app.marketKeeper = *marketKeeper.SetHooks(market.NewMultiMarketHooks())
 	app.treasuryKeeper = treasury.NewKeeper(app.cdc, keys[treasury.StoreKey], treasurySubspace,
 		app.supplyKeeper, app.marketKeeper, &stakingKeeper, app.distrKeeper,
 		oracle.ModuleName, distr.ModuleName, treasury.DefaultCodespace)
@@ -180,7 +186,8 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
-		AddRoute(treasury.RouterKey, treasury.NewTreasuryPolicyUpdateHandler(app.treasuryKeeper))
+		AddRoute(treasury.RouterKey, treasury.NewTreasuryPolicyUpdateHandler(app.treasuryKeeper)).
+		AddRoute(market.RouterKey, market.NewMarketPolicyUpdateHandler(app.marketKeeper))
 	app.govKeeper = gov.NewKeeper(app.cdc, keys[gov.StoreKey], app.paramsKeeper, govSubspace,
 		app.supplyKeeper, &stakingKeeper, gov.DefaultCodespace, govRouter)
 
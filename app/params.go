@@ -15,6 +15,8 @@ const (
 	OpWeightSubmitVotingSlashingParamChangeProposal        = "op_weight_submit_voting_slashing_param_change_proposal"
 	OpWeightSubmitVotingSlashingTaxRateUpdateProposal      = "op_weight_submit_voting_slashing_tax_rate_update_proposal"
 	OpWeightSubmitVotingSlashingRewardWeightUpdateProposal = "op_weight_submit_voting_slashing_reward_weight_update_proposal"
+	OpWeightSubmitVotingSlashingCircuitBreakerProposal     = "op_weight_submit_voting_slashing_circuit_breaker_proposal"
+	OpWeightSubmitVotingSlashingDenomParamsUpdateProposal  = "op_weight_submit_voting_slashing_denom_params_update_proposal"
 	OpWeightMsgDeposit                                     = "op_weight_msg_deposit"
 	OpWeightMsgCreateValidator                             = "op_weight_msg_create_validator"
 	OpWeightMsgEditValidator                               = "op_weight_msg_edit_validator"
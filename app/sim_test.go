@@ -310,6 +310,28 @@ func testAndRunTxs(app *TerraApp) []simulation.WeightedOperation {
 			}(nil),
 			govsim.SimulateSubmittingVotingAndSlashingForProposal(app.govKeeper, treasurysim.SimulateRewardWeightUpdateProposalContent(app.treasuryKeeper)),
 		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightSubmitVotingSlashingCircuitBreakerProposal, &v, nil,
+					func(_ *rand.Rand) {
+						v = 5
+					})
+				return v
+			}(nil),
+			govsim.SimulateSubmittingVotingAndSlashingForProposal(app.govKeeper, marketsim.SimulateCircuitBreakerProposalContent(app.marketKeeper)),
+		},
+		{
+			func(_ *rand.Rand) int {
+				var v int
+				ap.GetOrGenerate(cdc, OpWeightSubmitVotingSlashingDenomParamsUpdateProposal, &v, nil,
+					func(_ *rand.Rand) {
+						v = 5
+					})
+				return v
+			}(nil),
+			govsim.SimulateSubmittingVotingAndSlashingForProposal(app.govKeeper, marketsim.SimulateDenomParamsUpdateProposalContent(app.marketKeeper)),
+		},
 		{
 			func(_ *rand.Rand) int {
 				var v int
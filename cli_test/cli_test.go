@@ -30,6 +30,7 @@ import (
 	distr "github.com/terra-project/core/x/distribution"
 	"github.com/terra-project/core/x/genaccounts"
 	"github.com/terra-project/core/x/gov"
+	"github.com/terra-project/core/x/oracle"
 )
 
 func init() {
@@ -481,6 +482,64 @@ func TestTerraCLIQuerySupply(t *testing.T) {
 	f.Cleanup()
 }
 
+func TestTerraCLIOracleFeeder(t *testing.T) {
+	t.Parallel()
+	f := InitFixtures(t)
+	cdc := app.MakeCodec()
+
+	// Vote on a single denom every two blocks
+	genesisState := f.GenesisState()
+	var oracleData oracle.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[oracle.ModuleName], &oracleData)
+	oracleData.Params.VotePeriod = 2
+	oracleData.Params.Whitelist = oracle.DenomList{core.MicroKRWDenom}
+	oracleDataBz, err := cdc.MarshalJSON(oracleData)
+	require.NoError(t, err)
+	genesisState[oracle.ModuleName] = oracleDataBz
+
+	genFile := filepath.Join(f.TerradHome, "config", "genesis.json")
+	genDoc, err := tmtypes.GenesisDocFromFile(genFile)
+	require.NoError(t, err)
+	genDoc.AppState, err = cdc.MarshalJSON(genesisState)
+	require.NoError(t, genDoc.SaveAs(genFile))
+
+	// start terrad server
+	proc := f.TDStart()
+	defer proc.Stop(false)
+
+	source := filepath.Join(f.RootDir, "prices.json")
+	require.NoError(t, ioutil.WriteFile(source, []byte(`[{"denom":"ukrw","price":"8888.0"}]`), 0600))
+
+	fooAddr := f.KeyAddress(keyFoo)
+	fooVal := sdk.ValAddress(fooAddr)
+	feeder := f.OracleFeeder(fooVal, source, fmt.Sprintf("--from=%s", keyFoo), "--poll-interval=500ms")
+	defer feeder.Stop(false)
+
+	// The prevote of one period is revealed in the next one, and tallied at its end
+	tests.WaitForNextNBlocksTM(10, f.Port)
+
+	searchResult := f.QueryTxs(1, 50, "message.action:aggregatepricevote", fmt.Sprintf("aggregate_vote.voter:%s", fooVal))
+	require.NotEmpty(t, searchResult.Txs)
+
+	priceInfo := f.QueryOraclePrice(core.MicroKRWDenom)
+	require.Equal(t, sdk.NewDec(8888), priceInfo.Price)
+
+	// A restarted feeder resumes from its state file and keeps the price up to date
+	require.NoError(t, feeder.Stop(false))
+	tests.WaitForNextNBlocksTM(1, f.Port)
+
+	require.NoError(t, ioutil.WriteFile(source, []byte(`[{"denom":"ukrw","price":"9999.0"}]`), 0600))
+	feeder = f.OracleFeeder(fooVal, source, fmt.Sprintf("--from=%s", keyFoo), "--poll-interval=500ms")
+	defer feeder.Stop(false)
+
+	tests.WaitForNextNBlocksTM(10, f.Port)
+
+	priceInfo = f.QueryOraclePrice(core.MicroKRWDenom)
+	require.Equal(t, sdk.NewDec(9999), priceInfo.Price)
+
+	f.Cleanup()
+}
+
 func TestTerraCLISubmitProposal(t *testing.T) {
 	t.Parallel()
 	f := InitFixtures(t)
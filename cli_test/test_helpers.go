@@ -33,6 +33,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	authutils "github.com/terra-project/core/x/auth/client/utils"
+	"github.com/terra-project/core/x/oracle"
 )
 
 const (
@@ -698,6 +699,30 @@ func (f *Fixtures) QueryTotalSupplyOf(denom string, flags ...string) sdk.Int {
 	return supplyOf
 }
 
+//___________________________________________________________________________________
+// terracli oracle
+
+// OracleFeeder is terracli oracle feeder; returns the running feeder process
+func (f *Fixtures) OracleFeeder(valAddr sdk.ValAddress, source string, flags ...string) *tests.Process {
+	cmd := fmt.Sprintf("%s oracle feeder %s --source=%s %v", f.TerracliBinary, valAddr, source, f.Flags())
+	proc := tests.GoExecuteT(f.T, addFlags(cmd, flags))
+	_, err := proc.StdinPipe.Write([]byte(client.DefaultKeyPass + "\n"))
+	require.NoError(f.T, err)
+	return proc
+}
+
+// QueryOraclePrice is terracli query oracle price
+func (f *Fixtures) QueryOraclePrice(denom string, flags ...string) oracle.PriceInfo {
+	cmd := fmt.Sprintf("%s query oracle price %s %v", f.TerracliBinary, denom, f.Flags())
+	res, errStr := tests.ExecuteT(f.T, addFlags(cmd, flags), "")
+	require.Empty(f.T, errStr)
+	cdc := app.MakeCodec()
+	var info oracle.PriceInfo
+	err := cdc.UnmarshalJSON([]byte(res), &info)
+	require.NoError(f.T, err)
+	return info
+}
+
 //___________________________________________________________________________________
 // executors
 
@@ -29,6 +29,7 @@ import (
 	tauthrest "github.com/terra-project/core/x/auth/client/rest"
 	"github.com/terra-project/core/x/bank"
 	tbankcmd "github.com/terra-project/core/x/bank/client/cli"
+	oraclecmd "github.com/terra-project/core/x/oracle/client/cli"
 )
 
 func main() {
@@ -68,6 +69,7 @@ func main() {
 		client.ConfigCmd(app.DefaultCLIHome),
 		queryCmd(cdc),
 		txCmd(cdc),
+		oracleCmd(cdc),
 		client.LineBreak,
 		lcd.ServeCommand(cdc, registerRoutes),
 		client.LineBreak,
@@ -163,6 +165,19 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 	return txCmd
 }
 
+func oracleCmd(cdc *amino.Codec) *cobra.Command {
+	oracleCmd := &cobra.Command{
+		Use:   "oracle",
+		Short: "Oracle feeder subcommands",
+	}
+
+	oracleCmd.AddCommand(client.PostCommands(
+		oraclecmd.GetCmdFeeder(cdc),
+	)...)
+
+	return oracleCmd
+}
+
 // registerRoutes registers the routes from the different modules for the LCD.
 // NOTE: details on the routes added for each module are in the module documentation
 // NOTE: If making updates here you also need to update the test helper in client/lcd/test_helper.go
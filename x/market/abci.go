@@ -1,6 +1,8 @@
 package market
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	core "github.com/terra-project/core/types"
@@ -9,17 +11,222 @@ import (
 
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k Keeper) {
-	if !core.IsPeriodLastBlock(ctx, core.BlocksPerDay) {
+	// Replenish the virtual pools toward the base pool every block
+	k.ReplenishPools(ctx)
+
+	// Match resting limit orders against the prices the oracle has just updated
+	matchLimitOrders(ctx, k)
+
+	// Execute the due swap schedules at the same prices
+	executeSwapSchedules(ctx, k)
+
+	// Prune the swap volumes out of retention at the end of every epoch
+	if core.IsPeriodLastBlock(ctx, core.BlocksPerEpoch) {
+		k.PruneSwapVolumes(ctx)
+	}
+
+	if !core.IsPeriodLastBlock(ctx, core.BlocksPerHour) {
 		return
 	}
 
-	// update luna issuance at last block of a day
-	updatedIssuance := k.UpdatePrevDayIssuance(ctx)
+	// record luna issuance at last block of an hour, for the rolling window of luna supply change
+	updatedIssuance := k.RecordHourlyIssuance(ctx)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			types.EventDaliyIssuanceUpdate,
+			types.EventIssuanceUpdate,
+			sdk.NewAttribute(types.AttributeKeyHour, fmt.Sprintf("%d", types.GetIssuanceHour(ctx))),
 			sdk.NewAttribute(types.AttributeKeyIssuance, updatedIssuance.String()),
 		),
 	)
 }
+
+// matchLimitOrders tries up to MaxLimitOrderMatches resting limit orders for execution at the current
+// oracle exchange rates, resuming in placement order from where the previous block stopped, and refunds
+// the orders that expire at this block
+func matchLimitOrders(ctx sdk.Context, k Keeper) {
+	maxMatches := k.MaxLimitOrderMatches(ctx)
+	cursor := k.GetLimitOrderCursor(ctx)
+
+	var orders []LimitOrder
+	collect := func(order LimitOrder) (stop bool) {
+		if int64(len(orders)) >= maxMatches {
+			return true
+		}
+
+		orders = append(orders, order)
+		return false
+	}
+
+	k.IterateLimitOrdersFrom(ctx, cursor, collect)
+	k.IterateLimitOrders(ctx, func(order LimitOrder) (stop bool) {
+		// Wrap around to the orders placed before the cursor
+		if order.OrderID >= cursor {
+			return true
+		}
+		return collect(order)
+	})
+
+	if len(orders) != 0 {
+		k.SetLimitOrderCursor(ctx, orders[len(orders)-1].OrderID+1)
+	}
+
+	for _, order := range orders {
+		executeLimitOrder(ctx, k, order)
+	}
+
+	var expiredOrders []LimitOrder
+	k.IterateExpiredLimitOrders(ctx, ctx.BlockHeight(), func(order LimitOrder) (stop bool) {
+		expiredOrders = append(expiredOrders, order)
+		return false
+	})
+
+	for _, order := range expiredOrders {
+		refundLimitOrder(ctx, k, order, types.EventExpireLimitOrder)
+	}
+}
+
+// refundLimitOrder refunds the escrowed offer coins of the order to the trader and deletes the order
+func refundLimitOrder(ctx sdk.Context, k Keeper, order LimitOrder, eventType string) {
+	err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, order.Trader, sdk.NewCoins(order.OfferCoin))
+	if err != nil {
+		panic(err)
+	}
+
+	k.DeleteLimitOrder(ctx, order.OrderID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.OrderID)),
+			sdk.NewAttribute(types.AttributeKeyTrader, order.Trader.String()),
+			sdk.NewAttribute(types.AttributeKeyOffer, order.OfferCoin.String()),
+		),
+	)
+}
+
+// executeLimitOrder swaps the escrowed offer coins of the order and credits the trader through the same
+// swap path as MsgSwap, if the swap meets the limit price; returns false with no state changed if the order
+// cannot be executed, e.g. for want of an oracle price or if a hook vetoes the swap. An order out of the
+// limit order bounds is cancelled and refunded instead.
+func executeLimitOrder(ctx sdk.Context, k Keeper, order LimitOrder) bool {
+	minAskAmount, ok := order.MinAskAmount()
+	if !ok {
+		refundLimitOrder(ctx, k, order, types.EventCancelLimitOrder)
+		return false
+	}
+
+	// The offer coins are already escrowed in the module account
+	fundSwap := func(ctx sdk.Context) sdk.Error {
+		return nil
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	hop, err := executeSwap(cacheCtx, k, order.Trader, order.Trader, order.OfferCoin, order.AskDenom, minAskAmount, fundSwap)
+	if err != nil {
+		return false
+	}
+
+	k.DeleteLimitOrder(cacheCtx, order.OrderID)
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventExecuteLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.OrderID)),
+			sdk.NewAttribute(types.AttributeKeyTrader, order.Trader.String()),
+			sdk.NewAttribute(types.AttributeKeyOffer, hop.OfferCoin.String()),
+			sdk.NewAttribute(types.AttributeKeySwapCoin, hop.SwapCoin.String()),
+			sdk.NewAttribute(types.AttributeKeySwapFee, hop.SwapFee.String()),
+		),
+	)
+
+	return true
+}
+
+// executeSwapSchedules executes up to MaxScheduleExecutions due swap schedules from the earliest due height,
+// then in creation order, through the same swap path as MsgSwap; the schedules left over stay due and are
+// executed at the next blocks
+func executeSwapSchedules(ctx sdk.Context, k Keeper) {
+	maxExecutions := k.MaxScheduleExecutions(ctx)
+
+	var schedules []SwapSchedule
+	k.IterateDueSwapSchedules(ctx, ctx.BlockHeight(), func(schedule SwapSchedule) (stop bool) {
+		if int64(len(schedules)) >= maxExecutions {
+			return true
+		}
+
+		schedules = append(schedules, schedule)
+		return false
+	})
+
+	for _, schedule := range schedules {
+		executeSwapSchedule(ctx, k, schedule)
+	}
+}
+
+// executeSwapSchedule swaps the offer coin of one execution from the escrow and credits the trader;
+// if the swap fails, e.g. for want of an oracle price, the execution is skipped and its offer coin is
+// refunded to the trader. Either way the execution counts against the remaining executions.
+func executeSwapSchedule(ctx sdk.Context, k Keeper, schedule SwapSchedule) {
+	schedule.NextHeight = ctx.BlockHeight() + schedule.Interval
+	schedule.Remaining--
+
+	// Send offer coins from the escrow to module account
+	fundSwap := func(ctx sdk.Context) sdk.Error {
+		return k.SupplyKeeper.SendCoinsFromModuleToModule(ctx, ScheduleEscrowName, ModuleName, sdk.NewCoins(schedule.OfferCoin))
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	hop, err := executeSwap(cacheCtx, k, schedule.Trader, schedule.Trader, schedule.OfferCoin, schedule.AskDenom, sdk.ZeroInt(), fundSwap)
+	if err != nil {
+		// Refund the escrowed offer coins of the skipped execution
+		refundErr := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, ScheduleEscrowName, schedule.Trader, sdk.NewCoins(schedule.OfferCoin))
+		if refundErr != nil {
+			panic(refundErr)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventSkipSwapSchedule,
+				sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", schedule.ScheduleID)),
+				sdk.NewAttribute(types.AttributeKeyTrader, schedule.Trader.String()),
+				sdk.NewAttribute(types.AttributeKeyOffer, schedule.OfferCoin.String()),
+				sdk.NewAttribute(types.AttributeKeyNextHeight, fmt.Sprintf("%d", schedule.NextHeight)),
+				sdk.NewAttribute(types.AttributeKeyRemaining, fmt.Sprintf("%d", schedule.Remaining)),
+				sdk.NewAttribute(types.AttributeKeyReason, err.ABCILog()),
+			),
+		)
+	} else {
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventExecuteSwapSchedule,
+				sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", schedule.ScheduleID)),
+				sdk.NewAttribute(types.AttributeKeyTrader, schedule.Trader.String()),
+				sdk.NewAttribute(types.AttributeKeyOffer, hop.OfferCoin.String()),
+				sdk.NewAttribute(types.AttributeKeySwapCoin, hop.SwapCoin.String()),
+				sdk.NewAttribute(types.AttributeKeySwapFee, hop.SwapFee.String()),
+				sdk.NewAttribute(types.AttributeKeyRemaining, fmt.Sprintf("%d", schedule.Remaining)),
+			),
+		)
+	}
+
+	if schedule.Remaining > 0 {
+		k.SetSwapSchedule(ctx, schedule)
+		return
+	}
+
+	k.DeleteSwapSchedule(ctx, schedule.ScheduleID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventCompleteSwapSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", schedule.ScheduleID)),
+			sdk.NewAttribute(types.AttributeKeyTrader, schedule.Trader.String()),
+		),
+	)
+}
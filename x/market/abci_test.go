@@ -1,6 +1,7 @@
 package market
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -8,6 +9,7 @@ import (
 
 	core "github.com/terra-project/core/types"
 	"github.com/terra-project/core/x/market/internal/keeper"
+	"github.com/terra-project/core/x/market/internal/types"
 )
 
 func TestOracleThreshold(t *testing.T) {
@@ -18,8 +20,255 @@ func TestOracleThreshold(t *testing.T) {
 	supply = supply.SetTotal(sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, targetIssuance)))
 	input.SupplyKeeper.SetSupply(input.Ctx, supply)
 
-	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerDay - 1)
+	// issuance is not recorded before the last block of an hour
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerHour - 2)
 	EndBlocker(input.Ctx, input.MarketKeeper)
-	issuance := input.MarketKeeper.GetPrevDayIssuance(input.Ctx).AmountOf(core.MicroLunaDenom)
+	require.Empty(t, input.MarketKeeper.GetIssuanceBuckets(input.Ctx))
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerHour - 1)
+	EndBlocker(input.Ctx, input.MarketKeeper)
+	issuance := input.MarketKeeper.GetWindowBaseIssuance(input.Ctx).AmountOf(core.MicroLunaDenom)
 	require.Equal(t, targetIssuance, issuance)
 }
+
+func TestReplenishPools(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	delta := sdk.NewDec(1000000)
+	input.MarketKeeper.SetTerraPoolDelta(input.Ctx, delta)
+
+	EndBlocker(input.Ctx, input.MarketKeeper)
+
+	recoveryPeriod := input.MarketKeeper.PoolRecoveryPeriod(input.Ctx)
+	require.Equal(t, delta.Sub(delta.QuoInt64(recoveryPeriod)), input.MarketKeeper.GetTerraPoolDelta(input.Ctx))
+}
+
+func TestMatchLimitOrders(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	lunaPriceInSDR := sdk.NewDec(1700)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, lunaPriceInSDR)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	escrow := sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, offerCoin.Amount.MulRaw(2)))
+	require.NoError(t, input.SupplyKeeper.SendCoinsFromAccountToModule(input.Ctx, keeper.Addrs[0], ModuleName, escrow))
+
+	// order 1 is met at the current price; order 2 asks for more than the oracle gives
+	input.MarketKeeper.AddLimitOrder(input.Ctx, NewLimitOrder(0, keeper.Addrs[0], offerCoin, core.MicroSDRDenom, sdk.NewDec(1000), 5))
+	input.MarketKeeper.AddLimitOrder(input.Ctx, NewLimitOrder(0, keeper.Addrs[0], offerCoin, core.MicroSDRDenom, sdk.NewDec(2000), 5))
+
+	traderBalance := input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins()
+
+	input.Ctx = input.Ctx.WithBlockHeight(4)
+	EndBlocker(input.Ctx, input.MarketKeeper)
+
+	_, err := input.MarketKeeper.GetLimitOrder(input.Ctx, 1)
+	require.Error(t, err)
+	_, err = input.MarketKeeper.GetLimitOrder(input.Ctx, 2)
+	require.NoError(t, err)
+
+	swapAmt := input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins().AmountOf(core.MicroSDRDenom).
+		Sub(traderBalance.AmountOf(core.MicroSDRDenom))
+	require.True(t, swapAmt.GTE(sdk.NewInt(10000)))
+	require.True(t, swapAmt.LT(sdk.NewInt(17000)))
+
+	// order 2 is refunded at its expiry height
+	input.Ctx = input.Ctx.WithBlockHeight(5)
+	EndBlocker(input.Ctx, input.MarketKeeper)
+
+	_, err = input.MarketKeeper.GetLimitOrder(input.Ctx, 2)
+	require.Error(t, err)
+	require.Equal(t, traderBalance.AmountOf(core.MicroLunaDenom).Add(offerCoin.Amount),
+		input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins().AmountOf(core.MicroLunaDenom))
+	require.True(t, input.SupplyKeeper.GetModuleAccount(input.Ctx, ModuleName).GetCoins().Empty())
+}
+
+func TestMatchLimitOrdersCap(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, sdk.NewDec(1700))
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.MaxLimitOrderMatches = 2
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	escrow := sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, offerCoin.Amount.MulRaw(3)))
+	require.NoError(t, input.SupplyKeeper.SendCoinsFromAccountToModule(input.Ctx, keeper.Addrs[0], ModuleName, escrow))
+
+	// order 1 never fills; orders 2 and 3 are met at the current price
+	input.MarketKeeper.AddLimitOrder(input.Ctx, NewLimitOrder(0, keeper.Addrs[0], offerCoin, core.MicroSDRDenom, sdk.NewDec(2000), 10))
+	input.MarketKeeper.AddLimitOrder(input.Ctx, NewLimitOrder(0, keeper.Addrs[0], offerCoin, core.MicroSDRDenom, sdk.NewDec(1000), 10))
+	input.MarketKeeper.AddLimitOrder(input.Ctx, NewLimitOrder(0, keeper.Addrs[0], offerCoin, core.MicroSDRDenom, sdk.NewDec(1000), 10))
+
+	// only orders 1 and 2 are tried at the first block
+	input.Ctx = input.Ctx.WithBlockHeight(1).WithEventManager(sdk.NewEventManager())
+	EndBlocker(input.Ctx, input.MarketKeeper)
+
+	_, err := input.MarketKeeper.GetLimitOrder(input.Ctx, 2)
+	require.Error(t, err)
+	_, err = input.MarketKeeper.GetLimitOrder(input.Ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), input.MarketKeeper.GetLimitOrderCursor(input.Ctx))
+
+	// bank transfer events of the executed swap are emitted
+	var transferred bool
+	for _, event := range input.Ctx.EventManager().Events() {
+		if event.Type == "transfer" {
+			transferred = true
+		}
+	}
+	require.True(t, transferred)
+
+	// matching resumes at order 3, then wraps around to order 1
+	input.Ctx = input.Ctx.WithBlockHeight(2)
+	EndBlocker(input.Ctx, input.MarketKeeper)
+
+	_, err = input.MarketKeeper.GetLimitOrder(input.Ctx, 3)
+	require.Error(t, err)
+	_, err = input.MarketKeeper.GetLimitOrder(input.Ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), input.MarketKeeper.GetLimitOrderCursor(input.Ctx))
+}
+
+func TestLimitOrderWithoutPrice(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	require.NoError(t, input.SupplyKeeper.SendCoinsFromAccountToModule(input.Ctx, keeper.Addrs[0], ModuleName, sdk.NewCoins(offerCoin)))
+	input.MarketKeeper.AddLimitOrder(input.Ctx, NewLimitOrder(0, keeper.Addrs[0], offerCoin, core.MicroSDRDenom, sdk.OneDec(), 5))
+
+	// order rests while the oracle has no price for the ask denom
+	EndBlocker(input.Ctx.WithBlockHeight(4), input.MarketKeeper)
+	_, err := input.MarketKeeper.GetLimitOrder(input.Ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(offerCoin), input.SupplyKeeper.GetModuleAccount(input.Ctx, ModuleName).GetCoins())
+}
+
+func TestLimitOrderExtremeLimitPrice(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, sdk.NewDec(1700))
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(1000000))
+	require.NoError(t, input.SupplyKeeper.SendCoinsFromAccountToModule(input.Ctx, keeper.Addrs[0], ModuleName, sdk.NewCoins(offerCoin)))
+
+	// an order placed around ValidateBasic, e.g. from an older version, with a limit price overflowing the min ask amount
+	limitPrice, err := sdk.NewDecFromStr("1" + strings.Repeat("0", 75))
+	require.NoError(t, err)
+	input.MarketKeeper.AddLimitOrder(input.Ctx, NewLimitOrder(0, keeper.Addrs[0], offerCoin, core.MicroSDRDenom, limitPrice, 5))
+
+	traderBalance := input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins()
+
+	// the order is cancelled and refunded instead of halting the chain
+	require.NotPanics(t, func() { EndBlocker(input.Ctx.WithBlockHeight(1), input.MarketKeeper) })
+
+	_, err = input.MarketKeeper.GetLimitOrder(input.Ctx, 1)
+	require.Error(t, err)
+	require.Equal(t, traderBalance.Add(sdk.NewCoins(offerCoin)), input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins())
+	require.True(t, input.SupplyKeeper.GetModuleAccount(input.Ctx, ModuleName).GetCoins().Empty())
+}
+
+func TestExecuteSwapSchedules(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, sdk.NewDec(1700))
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	escrow := sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, offerCoin.Amount.MulRaw(2)))
+	require.NoError(t, input.SupplyKeeper.SendCoinsFromAccountToModule(input.Ctx, keeper.Addrs[0], ScheduleEscrowName, escrow))
+	input.MarketKeeper.AddSwapSchedule(input.Ctx, NewSwapSchedule(0, keeper.Addrs[0], offerCoin, core.MicroSDRDenom, 5, 2, 5))
+
+	traderBalance := input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins()
+
+	// schedule is not executed before it is due
+	EndBlocker(input.Ctx.WithBlockHeight(4), input.MarketKeeper)
+	require.Equal(t, traderBalance, input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins())
+
+	input.Ctx = input.Ctx.WithBlockHeight(5)
+	EndBlocker(input.Ctx, input.MarketKeeper)
+
+	schedule, err := input.MarketKeeper.GetSwapSchedule(input.Ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), schedule.Remaining)
+	require.Equal(t, int64(10), schedule.NextHeight)
+
+	swapAmt := input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins().AmountOf(core.MicroSDRDenom).
+		Sub(traderBalance.AmountOf(core.MicroSDRDenom))
+	require.True(t, swapAmt.IsPositive())
+	require.Equal(t, sdk.NewCoins(offerCoin), input.SupplyKeeper.GetModuleAccount(input.Ctx, ScheduleEscrowName).GetCoins())
+
+	// schedule is removed after its last execution
+	input.Ctx = input.Ctx.WithBlockHeight(10)
+	EndBlocker(input.Ctx, input.MarketKeeper)
+
+	_, err = input.MarketKeeper.GetSwapSchedule(input.Ctx, 1)
+	require.Error(t, err)
+	require.True(t, input.SupplyKeeper.GetModuleAccount(input.Ctx, ScheduleEscrowName).GetCoins().Empty())
+	require.True(t, input.SupplyKeeper.GetModuleAccount(input.Ctx, ModuleName).GetCoins().Empty())
+}
+
+func TestSwapScheduleWithoutPrice(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	escrow := sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, offerCoin.Amount.MulRaw(2)))
+	require.NoError(t, input.SupplyKeeper.SendCoinsFromAccountToModule(input.Ctx, keeper.Addrs[0], ScheduleEscrowName, escrow))
+	input.MarketKeeper.AddSwapSchedule(input.Ctx, NewSwapSchedule(0, keeper.Addrs[0], offerCoin, core.MicroSDRDenom, 5, 2, 5))
+
+	traderBalance := input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins()
+
+	// execution is skipped while the oracle has no price for the ask denom, and its offer coin is refunded
+	input.Ctx = input.Ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+	EndBlocker(input.Ctx, input.MarketKeeper)
+
+	schedule, err := input.MarketKeeper.GetSwapSchedule(input.Ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), schedule.Remaining)
+	require.Equal(t, int64(10), schedule.NextHeight)
+	require.Equal(t, sdk.NewCoins(offerCoin), input.SupplyKeeper.GetModuleAccount(input.Ctx, ScheduleEscrowName).GetCoins())
+	require.Equal(t, traderBalance.Add(sdk.NewCoins(offerCoin)), input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins())
+
+	var skipped bool
+	for _, event := range input.Ctx.EventManager().Events() {
+		if event.Type == types.EventSkipSwapSchedule {
+			skipped = true
+		}
+	}
+	require.True(t, skipped)
+
+	// the schedule is closed once its skipped executions use up the remaining executions
+	EndBlocker(input.Ctx.WithBlockHeight(10), input.MarketKeeper)
+
+	_, err = input.MarketKeeper.GetSwapSchedule(input.Ctx, 1)
+	require.Error(t, err)
+	require.True(t, input.SupplyKeeper.GetModuleAccount(input.Ctx, ScheduleEscrowName).GetCoins().Empty())
+	require.Equal(t, traderBalance.Add(escrow), input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins())
+}
+
+func TestExecuteSwapSchedulesCap(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, sdk.NewDec(1700))
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.MaxScheduleExecutions = 2
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	escrow := sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, offerCoin.Amount.MulRaw(3)))
+	require.NoError(t, input.SupplyKeeper.SendCoinsFromAccountToModule(input.Ctx, keeper.Addrs[0], ScheduleEscrowName, escrow))
+	for i := 0; i < 3; i++ {
+		input.MarketKeeper.AddSwapSchedule(input.Ctx, NewSwapSchedule(0, keeper.Addrs[0], offerCoin, core.MicroSDRDenom, 5, 1, 5))
+	}
+
+	// only schedules 1 and 2 are executed at the due block
+	EndBlocker(input.Ctx.WithBlockHeight(5), input.MarketKeeper)
+
+	_, err := input.MarketKeeper.GetSwapSchedule(input.Ctx, 2)
+	require.Error(t, err)
+	_, err = input.MarketKeeper.GetSwapSchedule(input.Ctx, 3)
+	require.NoError(t, err)
+
+	// schedule 3 is carried over to the next block
+	EndBlocker(input.Ctx.WithBlockHeight(6), input.MarketKeeper)
+
+	_, err = input.MarketKeeper.GetSwapSchedule(input.Ctx, 3)
+	require.Error(t, err)
+	require.True(t, input.SupplyKeeper.GetModuleAccount(input.Ctx, ScheduleEscrowName).GetCoins().Empty())
+}
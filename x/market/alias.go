@@ -11,55 +11,214 @@ import (
 )
 
 const (
-	DefaultCodespace     = types.DefaultCodespace
-	CodeInsufficientSwap = types.CodeInsufficientSwap
-	CodeNoEffectivePrice = types.CodeNoEffectivePrice
-	CodeRecursiveSwap    = types.CodeRecursiveSwap
-	CodeExceedsSwapLimit = types.CodeExceedsSwapLimit
-	ModuleName           = types.ModuleName
-	StoreKey             = types.StoreKey
-	RouterKey            = types.RouterKey
-	QuerierRoute         = types.QuerierRoute
-	DefaultParamspace    = types.DefaultParamspace
-	QuerySwap            = types.QuerySwap
-	QueryPrevDayIssuance = types.QueryPrevDayIssuance
-	QueryParameters      = types.QueryParameters
+	DefaultCodespace              = types.DefaultCodespace
+	CodeInsufficientSwap          = types.CodeInsufficientSwap
+	CodeNoEffectivePrice          = types.CodeNoEffectivePrice
+	CodeRecursiveSwap             = types.CodeRecursiveSwap
+	CodeExceedsSwapLimit          = types.CodeExceedsSwapLimit
+	CodeSlippage                  = types.CodeSlippage
+	CodeEmptySwapRoute            = types.CodeEmptySwapRoute
+	CodeNoLimitOrder              = types.CodeNoLimitOrder
+	CodeInvalidExpiry             = types.CodeInvalidExpiry
+	CodeInvalidEpoch              = types.CodeInvalidEpoch
+	CodeCircuitBreaker            = types.CodeCircuitBreaker
+	CodeNoSwapSchedule            = types.CodeNoSwapSchedule
+	CodeInvalidSchedule           = types.CodeInvalidSchedule
+	CodePriceUnsettled            = types.CodePriceUnsettled
+	CodeStalePrice                = types.CodeStalePrice
+	CodeInvalidLimit              = types.CodeInvalidLimit
+	CodeSwapRouteTooLong          = types.CodeSwapRouteTooLong
+	ModuleName                    = types.ModuleName
+	StoreKey                      = types.StoreKey
+	RouterKey                     = types.RouterKey
+	QuerierRoute                  = types.QuerierRoute
+	ScheduleEscrowName            = types.ScheduleEscrowName
+	DefaultParamspace             = types.DefaultParamspace
+	QuerySwap                     = types.QuerySwap
+	QueryRouteSwap                = types.QueryRouteSwap
+	QuerySwapSimulation           = types.QuerySwapSimulation
+	QueryPrevDayIssuance          = types.QueryPrevDayIssuance
+	QueryIssuanceHistory          = types.QueryIssuanceHistory
+	MaxSwapRouteHops              = types.MaxSwapRouteHops
+	QueryTerraPoolDelta           = types.QueryTerraPoolDelta
+	QueryLimitOrder               = types.QueryLimitOrder
+	QueryLimitOrders              = types.QueryLimitOrders
+	QueryTobinTax                 = types.QueryTobinTax
+	QuerySwapFeeProceeds          = types.QuerySwapFeeProceeds
+	QuerySwapVolume               = types.QuerySwapVolume
+	QueryTraderSwapVolume         = types.QueryTraderSwapVolume
+	QuerySwapSchedule             = types.QuerySwapSchedule
+	QuerySwapSchedules            = types.QuerySwapSchedules
+	QueryParameters               = types.QueryParameters
+	SpreadModelConstantProduct    = types.SpreadModelConstantProduct
+	SpreadModelLinear             = types.SpreadModelLinear
+	IssuanceWindowHours           = types.IssuanceWindowHours
+	ProposalTypeCircuitBreaker    = types.ProposalTypeCircuitBreaker
+	ProposalTypeDenomParamsUpdate = types.ProposalTypeDenomParamsUpdate
 )
 
 var (
 	// functions aliases
-	RegisterCodec            = types.RegisterCodec
-	ErrNoEffectivePrice      = types.ErrNoEffectivePrice
-	ErrInsufficientSwapCoins = types.ErrInsufficientSwapCoins
-	ErrRecursiveSwap         = types.ErrRecursiveSwap
-	ErrExceedsDailySwapLimit = types.ErrExceedsDailySwapLimit
-	NewGenesisState          = types.NewGenesisState
-	DefaultGenesisState      = types.DefaultGenesisState
-	ValidateGenesis          = types.ValidateGenesis
-	NewMsgSwap               = types.NewMsgSwap
-	DefaultParams            = types.DefaultParams
-	NewQuerySwapParams       = types.NewQuerySwapParams
-	NewKeeper                = keeper.NewKeeper
-	ParamKeyTable            = keeper.ParamKeyTable
-	NewQuerier               = keeper.NewQuerier
+	RegisterCodec                  = types.RegisterCodec
+	ErrNoEffectivePrice            = types.ErrNoEffectivePrice
+	ErrPriceUnsettled              = types.ErrPriceUnsettled
+	ErrStalePrice                  = types.ErrStalePrice
+	ErrInsufficientSwapCoins       = types.ErrInsufficientSwapCoins
+	ErrRecursiveSwap               = types.ErrRecursiveSwap
+	ErrExceedsDailySwapLimit       = types.ErrExceedsDailySwapLimit
+	ErrSlippage                    = types.ErrSlippage
+	ErrEmptySwapRoute              = types.ErrEmptySwapRoute
+	ErrSwapRouteTooLong            = types.ErrSwapRouteTooLong
+	ErrNoLimitOrder                = types.ErrNoLimitOrder
+	ErrNoSwapSchedule              = types.ErrNoSwapSchedule
+	ErrInvalidSwapSchedule         = types.ErrInvalidSwapSchedule
+	ErrExceedsSwapScheduleEscrow   = types.ErrExceedsSwapScheduleEscrow
+	ErrInvalidExpiryHeight         = types.ErrInvalidExpiryHeight
+	ErrInvalidLimitPrice           = types.ErrInvalidLimitPrice
+	ErrInvalidEpoch                = types.ErrInvalidEpoch
+	ErrCircuitBreakerTripped       = types.ErrCircuitBreakerTripped
+	ErrCircuitBreakerHalted        = types.ErrCircuitBreakerHalted
+	NewGenesisState                = types.NewGenesisState
+	DefaultGenesisState            = types.DefaultGenesisState
+	ValidateGenesis                = types.ValidateGenesis
+	NewMsgSwap                     = types.NewMsgSwap
+	NewMsgSwapSend                 = types.NewMsgSwapSend
+	NewMsgRouteSwap                = types.NewMsgRouteSwap
+	ValidateSwapRoute              = types.ValidateSwapRoute
+	NewMsgPlaceLimitOrder          = types.NewMsgPlaceLimitOrder
+	NewMsgCancelLimitOrder         = types.NewMsgCancelLimitOrder
+	NewMsgCreateSwapSchedule       = types.NewMsgCreateSwapSchedule
+	NewMsgCancelSwapSchedule       = types.NewMsgCancelSwapSchedule
+	NewLimitOrder                  = types.NewLimitOrder
+	LimitOrderMinAskAmount         = types.LimitOrderMinAskAmount
+	NewSwapSchedule                = types.NewSwapSchedule
+	IsValidSwapScheduleEscrow      = types.IsValidSwapScheduleEscrow
+	GetLimitOrderKey               = types.GetLimitOrderKey
+	GetLimitOrderExpiryKey         = types.GetLimitOrderExpiryKey
+	GetSwapScheduleQueuePrefixKey  = types.GetSwapScheduleQueuePrefixKey
+	GetSwapScheduleQueueKey        = types.GetSwapScheduleQueueKey
+	GetLimitOrderExpiryPrefixKey   = types.GetLimitOrderExpiryPrefixKey
+	GetSwapScheduleKey             = types.GetSwapScheduleKey
+	GetIssuanceBucketKey           = types.GetIssuanceBucketKey
+	NewIssuanceBucket              = types.NewIssuanceBucket
+	GetIssuanceHour                = types.GetIssuanceHour
+	GetSwapFeeProceedsKey          = types.GetSwapFeeProceedsKey
+	GetSwapVolumeKey               = types.GetSwapVolumeKey
+	GetTraderSwapVolumeKey         = types.GetTraderSwapVolumeKey
+	NewQueryLimitOrderParams       = types.NewQueryLimitOrderParams
+	NewQueryLimitOrdersParams      = types.NewQueryLimitOrdersParams
+	NewQuerySwapScheduleParams     = types.NewQuerySwapScheduleParams
+	NewQuerySwapSchedulesParams    = types.NewQuerySwapSchedulesParams
+	NewQueryTobinTaxParams         = types.NewQueryTobinTaxParams
+	NewQuerySwapFeeProceedsParams  = types.NewQuerySwapFeeProceedsParams
+	NewQuerySwapVolumeParams       = types.NewQuerySwapVolumeParams
+	NewQueryTraderSwapVolumeParams = types.NewQueryTraderSwapVolumeParams
+	NewTobinTax                    = types.NewTobinTax
+	NewDenomParams                 = types.NewDenomParams
+	NewMultiMarketHooks            = types.NewMultiMarketHooks
+	NewCircuitBreakerProposal      = types.NewCircuitBreakerProposal
+	NewDenomParamsUpdateProposal   = types.NewDenomParamsUpdateProposal
+	DefaultParams                  = types.DefaultParams
+	NewQuerySwapParams             = types.NewQuerySwapParams
+	NewQueryRouteSwapParams        = types.NewQueryRouteSwapParams
+	NewSwapHop                     = types.NewSwapHop
+	NewKeeper                      = keeper.NewKeeper
+	ParamKeyTable                  = keeper.ParamKeyTable
+	NewQuerier                     = keeper.NewQuerier
 
 	// variable aliases
-	ModuleCdc                      = types.ModuleCdc
-	PrevDayIssuanceKey             = types.PrevDayIssuanceKey
-	ParamStoreKeyDailyLunaDeltaCap = types.ParamStoreKeyDailyLunaDeltaCap
-	ParamStoreKeyMaxSwapSpread     = types.ParamStoreKeyMaxSwapSpread
-	ParamStoreKeyMinSwapSpread     = types.ParamStoreKeyMinSwapSpread
-	DefaultDailyLunaDeltaCap       = types.DefaultDailyLunaDeltaCap
-	DefaultMaxSwapSpread           = types.DefaultMaxSwapSpread
-	DefaultMinSwapSpread           = types.DefaultMinSwapSpread
+	ModuleCdc                          = types.ModuleCdc
+	MaxSwapScheduleEscrow              = types.MaxSwapScheduleEscrow
+	MaxLimitPrice                      = types.MaxLimitPrice
+	MaxLimitOrderAskAmount             = types.MaxLimitOrderAskAmount
+	PrevDayIssuanceKey                 = types.PrevDayIssuanceKey
+	TerraPoolDeltaKey                  = types.TerraPoolDeltaKey
+	LimitOrderKey                      = types.LimitOrderKey
+	NextLimitOrderIDKey                = types.NextLimitOrderIDKey
+	SwapFeeProceedsKey                 = types.SwapFeeProceedsKey
+	IssuanceBucketKey                  = types.IssuanceBucketKey
+	SwapHaltedKey                      = types.SwapHaltedKey
+	SwapVolumeKey                      = types.SwapVolumeKey
+	TraderSwapVolumeKey                = types.TraderSwapVolumeKey
+	SwapScheduleKey                    = types.SwapScheduleKey
+	NextSwapScheduleIDKey              = types.NextSwapScheduleIDKey
+	LimitOrderCursorKey                = types.LimitOrderCursorKey
+	LimitOrderExpiryKey                = types.LimitOrderExpiryKey
+	CircuitBreakerResetKey             = types.CircuitBreakerResetKey
+	SwapScheduleQueueKey               = types.SwapScheduleQueueKey
+	ParamStoreKeyDailyLunaDeltaCap     = types.ParamStoreKeyDailyLunaDeltaCap
+	ParamStoreKeyMaxSwapSpread         = types.ParamStoreKeyMaxSwapSpread
+	ParamStoreKeyMinSwapSpread         = types.ParamStoreKeyMinSwapSpread
+	ParamStoreKeyBasePool              = types.ParamStoreKeyBasePool
+	ParamStoreKeyPoolRecoveryPeriod    = types.ParamStoreKeyPoolRecoveryPeriod
+	ParamStoreKeySpreadModel           = types.ParamStoreKeySpreadModel
+	ParamStoreKeyTobinTax              = types.ParamStoreKeyTobinTax
+	ParamStoreKeyTobinTaxOverrides     = types.ParamStoreKeyTobinTaxOverrides
+	ParamStoreKeyOracleFeeShare        = types.ParamStoreKeyOracleFeeShare
+	ParamStoreKeyLunaDeltaHardLimit    = types.ParamStoreKeyLunaDeltaHardLimit
+	ParamStoreKeyDenomParams           = types.ParamStoreKeyDenomParams
+	ParamStoreKeyVolumeRetention       = types.ParamStoreKeyVolumeRetention
+	ParamStoreKeyTraderVolume          = types.ParamStoreKeyTraderVolume
+	ParamStoreKeyMaxOraclePriceAge     = types.ParamStoreKeyMaxOraclePriceAge
+	ParamStoreKeyMaxLimitOrderMatches  = types.ParamStoreKeyMaxLimitOrderMatches
+	ParamStoreKeyMaxScheduleExecutions = types.ParamStoreKeyMaxScheduleExecutions
+	DefaultDailyLunaDeltaCap           = types.DefaultDailyLunaDeltaCap
+	DefaultMaxSwapSpread               = types.DefaultMaxSwapSpread
+	DefaultMinSwapSpread               = types.DefaultMinSwapSpread
+	DefaultBasePool                    = types.DefaultBasePool
+	DefaultPoolRecoveryPeriod          = types.DefaultPoolRecoveryPeriod
+	DefaultSpreadModel                 = types.DefaultSpreadModel
+	DefaultTobinTax                    = types.DefaultTobinTax
+	DefaultTobinTaxOverrides           = types.DefaultTobinTaxOverrides
+	DefaultOracleFeeShare              = types.DefaultOracleFeeShare
+	DefaultLunaDeltaHardLimit          = types.DefaultLunaDeltaHardLimit
+	DefaultDenomParams                 = types.DefaultDenomParams
+	DefaultVolumeRetention             = types.DefaultVolumeRetention
+	DefaultTraderVolume                = types.DefaultTraderVolume
+	DefaultMaxOraclePriceAge           = types.DefaultMaxOraclePriceAge
+	DefaultMaxLimitOrderMatches        = types.DefaultMaxLimitOrderMatches
+	DefaultMaxScheduleExecutions       = types.DefaultMaxScheduleExecutions
 )
 
 type (
-	SupplyKeeper    = types.SupplyKeeper
-	OracleKeeper    = types.OracleKeeper
-	GenesisState    = types.GenesisState
-	MsgSwap         = types.MsgSwap
-	Params          = types.Params
-	QuerySwapParams = types.QuerySwapParams
-	Keeper          = keeper.Keeper
+	SupplyKeeper                = types.SupplyKeeper
+	OracleKeeper                = types.OracleKeeper
+	DistributionKeeper          = types.DistributionKeeper
+	MarketHooks                 = types.MarketHooks
+	GenesisState                = types.GenesisState
+	MsgSwap                     = types.MsgSwap
+	MsgSwapSend                 = types.MsgSwapSend
+	MsgRouteSwap                = types.MsgRouteSwap
+	MsgPlaceLimitOrder          = types.MsgPlaceLimitOrder
+	MsgCancelLimitOrder         = types.MsgCancelLimitOrder
+	MsgCreateSwapSchedule       = types.MsgCreateSwapSchedule
+	MsgCancelSwapSchedule       = types.MsgCancelSwapSchedule
+	LimitOrder                  = types.LimitOrder
+	LimitOrders                 = types.LimitOrders
+	SwapSchedule                = types.SwapSchedule
+	SwapSchedules               = types.SwapSchedules
+	IssuanceBucket              = types.IssuanceBucket
+	IssuanceBuckets             = types.IssuanceBuckets
+	QueryLimitOrderParams       = types.QueryLimitOrderParams
+	QueryLimitOrdersParams      = types.QueryLimitOrdersParams
+	QuerySwapScheduleParams     = types.QuerySwapScheduleParams
+	QuerySwapSchedulesParams    = types.QuerySwapSchedulesParams
+	QueryTobinTaxParams         = types.QueryTobinTaxParams
+	QuerySwapFeeProceedsParams  = types.QuerySwapFeeProceedsParams
+	QuerySwapVolumeParams       = types.QuerySwapVolumeParams
+	QueryTraderSwapVolumeParams = types.QueryTraderSwapVolumeParams
+	TobinTax                    = types.TobinTax
+	TobinTaxList                = types.TobinTaxList
+	DenomParams                 = types.DenomParams
+	DenomParamsList             = types.DenomParamsList
+	MultiMarketHooks            = types.MultiMarketHooks
+	CircuitBreakerProposal      = types.CircuitBreakerProposal
+	DenomParamsUpdateProposal   = types.DenomParamsUpdateProposal
+	Params                      = types.Params
+	QuerySwapParams             = types.QuerySwapParams
+	QueryRouteSwapParams        = types.QueryRouteSwapParams
+	SwapHop                     = types.SwapHop
+	SwapHops                    = types.SwapHops
+	SwapSimulation              = types.SwapSimulation
+	Keeper                      = keeper.Keeper
 )
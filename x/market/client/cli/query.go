@@ -2,9 +2,11 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -14,6 +16,12 @@ import (
 	"github.com/terra-project/core/x/market/internal/types"
 )
 
+const (
+	flagTrader = "trader"
+	flagPage   = "page"
+	flagLimit  = "limit"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	marketQueryCmd := &cobra.Command{
@@ -26,8 +34,20 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	marketQueryCmd.AddCommand(client.GetCommands(
 		GetCmdQuerySwap(queryRoute, cdc),
+		GetCmdQueryRouteSwap(queryRoute, cdc),
+		GetCmdQuerySwapSimulation(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
 		GetCmdQueryPrevDayIssuance(queryRoute, cdc),
+		GetCmdQueryIssuanceHistory(queryRoute, cdc),
+		GetCmdQueryTerraPoolDelta(queryRoute, cdc),
+		GetCmdQueryLimitOrder(queryRoute, cdc),
+		GetCmdQueryLimitOrders(queryRoute, cdc),
+		GetCmdQuerySwapSchedule(queryRoute, cdc),
+		GetCmdQuerySwapSchedules(queryRoute, cdc),
+		GetCmdQueryTobinTax(queryRoute, cdc),
+		GetCmdQuerySwapFeeProceeds(queryRoute, cdc),
+		GetCmdQuerySwapVolume(queryRoute, cdc),
+		GetCmdQueryTraderSwapVolume(queryRoute, cdc),
 	)...)
 
 	return marketQueryCmd
@@ -72,12 +92,87 @@ $ terracli query query swap 5000000uluna usdr
 	return cmd
 }
 
-// GetCmdQueryPrevDayIssuance implements the query params command.
+// GetCmdQueryRouteSwap implements the query route swap simulation command.
+func GetCmdQueryRouteSwap(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "route-swap [offer-coin] [ask-denoms]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Simulate a route swap through several denoms",
+		Long: strings.TrimSpace(`
+Query the coins received and fees charged on every hop of a route swap through the comma-separated ask-denoms. Note; rates are dynamic and can quickly change.
+
+$ terracli query market route-swap 5000000ukrw usdr,uluna
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// parse offerCoin
+			offerCoinStr := args[0]
+			offerCoin, err := sdk.ParseCoin(offerCoinStr)
+			if err != nil {
+				return err
+			}
+
+			askDenoms := strings.Split(args[1], ",")
+
+			params := types.NewQueryRouteSwapParams(offerCoin, askDenoms)
+			bz := cdc.MustMarshalJSON(params)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRouteSwap), bz)
+			if err != nil {
+				return err
+			}
+
+			var hops types.SwapHops
+			cdc.MustUnmarshalJSON(res, &hops)
+			return cliCtx.PrintOutput(hops)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQuerySwapSimulation implements the query swap simulation command.
+func GetCmdQuerySwapSimulation(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-simulation [offer-coin] [ask-denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the breakdown of a quote for a swap operation",
+		Long: strings.TrimSpace(`
+Query the oracle exchange rates, the Luna supply change before and after the swap, the spread, the fee and
+the net return of a swap operation. Note; rates are dynamic and can quickly change.
+
+$ terracli query market swap-simulation 5000000uluna usdr
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			offerCoin, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQuerySwapParams(offerCoin, args[1])
+			bz := cdc.MustMarshalJSON(params)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySwapSimulation), bz)
+			if err != nil {
+				return err
+			}
+
+			var simulation types.SwapSimulation
+			cdc.MustUnmarshalJSON(res, &simulation)
+			return cliCtx.PrintOutput(simulation)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryPrevDayIssuance implements the query prev day issuance command.
 func GetCmdQueryPrevDayIssuance(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "prev-day-issuance",
-		Args:  cobra.ExactArgs(1),
-		Short: "Query the prev day issuance",
+		Args:  cobra.NoArgs,
+		Short: "Query the issuance at the start of the rolling 24-hour window",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
@@ -95,6 +190,64 @@ func GetCmdQueryPrevDayIssuance(queryRoute string, cdc *codec.Codec) *cobra.Comm
 	return cmd
 }
 
+// GetCmdQueryIssuanceHistory implements the query issuance history command.
+func GetCmdQueryIssuanceHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issuance-history",
+		Args:  cobra.NoArgs,
+		Short: "Query the hourly issuance buckets of the rolling 24-hour window",
+		Long: strings.TrimSpace(`
+Query the total issuance recorded at the last block of each hour, from the oldest hour. The Luna supply change
+that the daily Luna delta cap applies to is computed against the oldest bucket of the rolling 24-hour window.
+
+$ terracli query market issuance-history
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryIssuanceHistory), nil)
+			if err != nil {
+				return err
+			}
+
+			var buckets types.IssuanceBuckets
+			cdc.MustUnmarshalJSON(res, &buckets)
+			return cliCtx.PrintOutput(buckets)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryTerraPoolDelta implements the query terra pool delta command.
+func GetCmdQueryTerraPoolDelta(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "terra-pool-delta",
+		Args:  cobra.NoArgs,
+		Short: "Query the terra pool delta",
+		Long: strings.TrimSpace(`
+Query the gap between the virtual terra pool and the base pool, in usdr. A positive delta means the terra pool
+has been filled by Terra->Luna swaps; the delta recovers toward zero every block.
+
+$ terracli query market terra-pool-delta
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTerraPoolDelta), nil)
+			if err != nil {
+				return err
+			}
+
+			var terraPoolDelta sdk.Dec
+			cdc.MustUnmarshalJSON(res, &terraPoolDelta)
+			return cliCtx.PrintOutput(terraPoolDelta)
+		},
+	}
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -117,3 +270,299 @@ func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryLimitOrder implements the query limit order command.
+func GetCmdQueryLimitOrder(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit-order [order-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a resting limit order",
+		Long: strings.TrimSpace(`
+Query a resting limit order by its ID.
+
+$ terracli query market limit-order 12
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("given order-id {%s} is not a valid order ID", args[0])
+			}
+
+			params := types.NewQueryLimitOrderParams(orderID)
+			bz := cdc.MustMarshalJSON(params)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryLimitOrder), bz)
+			if err != nil {
+				return err
+			}
+
+			var order types.LimitOrder
+			cdc.MustUnmarshalJSON(res, &order)
+			return cliCtx.PrintOutput(order)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryLimitOrders implements the query limit orders command.
+func GetCmdQueryLimitOrders(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "limit-orders",
+		Args:  cobra.NoArgs,
+		Short: "Query resting limit orders",
+		Long: strings.TrimSpace(`
+Query resting limit orders in placement order, optionally of a trader.
+
+$ terracli query market limit-orders --trader terra1... --page 1 --limit 20
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var trader sdk.AccAddress
+			if traderStr := viper.GetString(flagTrader); len(traderStr) != 0 {
+				var err error
+				trader, err = sdk.AccAddressFromBech32(traderStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			params := types.NewQueryLimitOrdersParams(trader, viper.GetInt(flagPage), viper.GetInt(flagLimit))
+			bz := cdc.MustMarshalJSON(params)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryLimitOrders), bz)
+			if err != nil {
+				return err
+			}
+
+			var orders types.LimitOrders
+			cdc.MustUnmarshalJSON(res, &orders)
+			return cliCtx.PrintOutput(orders)
+		},
+	}
+
+	cmd.Flags().String(flagTrader, "", "(optional) filter orders by the trader address")
+	cmd.Flags().Int(flagPage, 1, "page of the orders to query")
+	cmd.Flags().Int(flagLimit, 100, "number of orders per page")
+
+	return cmd
+}
+
+// GetCmdQuerySwapSchedule implements the query swap schedule command.
+func GetCmdQuerySwapSchedule(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-schedule [schedule-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a swap schedule",
+		Long: strings.TrimSpace(`
+Query a swap schedule by its ID.
+
+$ terracli query market swap-schedule 3
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			scheduleID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("given schedule-id {%s} is not a valid schedule ID", args[0])
+			}
+
+			params := types.NewQuerySwapScheduleParams(scheduleID)
+			bz := cdc.MustMarshalJSON(params)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySwapSchedule), bz)
+			if err != nil {
+				return err
+			}
+
+			var schedule types.SwapSchedule
+			cdc.MustUnmarshalJSON(res, &schedule)
+			return cliCtx.PrintOutput(schedule)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQuerySwapSchedules implements the query swap schedules command.
+func GetCmdQuerySwapSchedules(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-schedules",
+		Args:  cobra.NoArgs,
+		Short: "Query swap schedules",
+		Long: strings.TrimSpace(`
+Query swap schedules in creation order, optionally of a trader.
+
+$ terracli query market swap-schedules --trader terra1... --page 1 --limit 20
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var trader sdk.AccAddress
+			if traderStr := viper.GetString(flagTrader); len(traderStr) != 0 {
+				var err error
+				trader, err = sdk.AccAddressFromBech32(traderStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			params := types.NewQuerySwapSchedulesParams(trader, viper.GetInt(flagPage), viper.GetInt(flagLimit))
+			bz := cdc.MustMarshalJSON(params)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySwapSchedules), bz)
+			if err != nil {
+				return err
+			}
+
+			var schedules types.SwapSchedules
+			cdc.MustUnmarshalJSON(res, &schedules)
+			return cliCtx.PrintOutput(schedules)
+		},
+	}
+
+	cmd.Flags().String(flagTrader, "", "(optional) filter schedules by the trader address")
+	cmd.Flags().Int(flagPage, 1, "page of the schedules to query")
+	cmd.Flags().Int(flagLimit, 100, "number of schedules per page")
+
+	return cmd
+}
+
+// GetCmdQueryTobinTax implements the query tobin tax command.
+func GetCmdQueryTobinTax(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tobin-tax [offer-denom] [ask-denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the Tobin tax rate charged on a Terra<>Terra swap",
+		Long: strings.TrimSpace(`
+Query the Tobin tax rate charged on a swap between two Terra denoms; the higher rate of the two denoms applies.
+
+$ terracli query market tobin-tax ukrw uusd
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryTobinTaxParams(args[0], args[1])
+			bz := cdc.MustMarshalJSON(params)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTobinTax), bz)
+			if err != nil {
+				return err
+			}
+
+			var taxRate sdk.Dec
+			cdc.MustUnmarshalJSON(res, &taxRate)
+			return cliCtx.PrintOutput(taxRate)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQuerySwapFeeProceeds implements the query swap-fee-proceeds command.
+func GetCmdQuerySwapFeeProceeds(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-fee-proceeds [epoch]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the swap fee proceeds for the epoch",
+		Long: strings.TrimSpace(`
+Query the swap fees collected in the given epoch. The return value will be sdk.Coins{} of all the swap fees sent to the oracle reward pool and the community pool.
+
+$ terracli query market swap-fee-proceeds 14
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			epoch, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQuerySwapFeeProceedsParams(epoch)
+			bz := cdc.MustMarshalJSON(params)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySwapFeeProceeds), bz)
+			if err != nil {
+				return err
+			}
+
+			var swapFeeProceeds sdk.Coins
+			cdc.MustUnmarshalJSON(res, &swapFeeProceeds)
+			return cliCtx.PrintOutput(swapFeeProceeds)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQuerySwapVolume implements the query swap-volume command.
+func GetCmdQuerySwapVolume(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-volume [epoch]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the swap volume for the epoch",
+		Long: strings.TrimSpace(`
+Query the cumulative swap volume per denom in the given epoch. Both the offered and the swapped coins of every swap are counted.
+
+$ terracli query market swap-volume 14
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			epoch, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQuerySwapVolumeParams(epoch)
+			bz := cdc.MustMarshalJSON(params)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySwapVolume), bz)
+			if err != nil {
+				return err
+			}
+
+			var swapVolume sdk.Coins
+			cdc.MustUnmarshalJSON(res, &swapVolume)
+			return cliCtx.PrintOutput(swapVolume)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryTraderSwapVolume implements the query trader-swap-volume command.
+func GetCmdQueryTraderSwapVolume(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trader-swap-volume [epoch] [trader]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the swap volume of a trader for the epoch",
+		Long: strings.TrimSpace(`
+Query the cumulative swap volume per denom of the trader in the given epoch. Per-trader volumes are only recorded while the trader_volume param is enabled.
+
+$ terracli query market trader-swap-volume 14 terra1...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			epoch, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			trader, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryTraderSwapVolumeParams(epoch, trader)
+			bz := cdc.MustMarshalJSON(params)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTraderSwapVolume), bz)
+			if err != nil {
+				return err
+			}
+
+			var swapVolume sdk.Coins
+			cdc.MustUnmarshalJSON(res, &swapVolume)
+			return cliCtx.PrintOutput(swapVolume)
+		},
+	}
+
+	return cmd
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/terra-project/core/x/market/internal/types"
@@ -9,10 +11,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagMinAskAmount = "min-ask-amount"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -27,6 +36,12 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	marketTxCmd.AddCommand(client.PostCommands(
 		GetSwapCmd(cdc),
+		GetSwapSendCmd(cdc),
+		GetRouteSwapCmd(cdc),
+		GetPlaceLimitOrderCmd(cdc),
+		GetCancelLimitOrderCmd(cdc),
+		GetCreateSwapScheduleCmd(cdc),
+		GetCancelSwapScheduleCmd(cdc),
 	)...)
 
 	return marketTxCmd
@@ -70,3 +85,401 @@ $ terracli market swap "1000ukrw" "uusd"
 
 	return cmd
 }
+
+// GetSwapSendCmd will create and send a MsgSwapSend
+func GetSwapSendCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-send [to-address] [offer-coin] [ask-denom]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Atomically swap currencies at their target exchange rate and send the result to another address",
+		Long: strings.TrimSpace(`
+Swap the offer-coin to the ask-denom currency at the oracle's effective exchange rate, and credit the swapped coin to to-address.
+The swap fails if the swapped coin after the spread fee is less than min-ask-amount.
+
+$ terracli market swap-send terra1... "1000ukrw" "uusd" --min-ask-amount 800
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			toAddress, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			offerCoinStr := args[1]
+			offerCoin, err := sdk.ParseCoin(offerCoinStr)
+			if err != nil {
+				return err
+			}
+
+			askDenom := args[2]
+
+			minAskAmount := sdk.ZeroInt()
+			if minAskAmountStr := viper.GetString(flagMinAskAmount); len(minAskAmountStr) != 0 {
+				var ok bool
+				minAskAmount, ok = sdk.NewIntFromString(minAskAmountStr)
+				if !ok {
+					return fmt.Errorf("given min-ask-amount {%s} is not a valid integer", minAskAmountStr)
+				}
+			}
+
+			fromAddress := cliCtx.GetFromAddress()
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgSwapSend(fromAddress, toAddress, offerCoin, askDenom, minAskAmount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagMinAskAmount, "", "(optional) minimum amount of the ask coin to receive after the spread fee; the swap fails otherwise")
+
+	return cmd
+}
+
+// GetRouteSwapCmd will create and send a MsgRouteSwap
+func GetRouteSwapCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "route-swap [offer-coin] [ask-denoms]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Atomically swap currencies through several denoms in one transaction",
+		Long: strings.TrimSpace(`
+Swap the offer-coin through the comma-separated ask-denoms in order, at the oracle's effective exchange rates.
+A spread is charged only on the swaps involving Luna. The swap fails if the coin of the last ask-denom
+after the spread fees is less than min-ask-amount.
+
+$ terracli market route-swap "1000ukrw" "usdr,uluna" --min-ask-amount 500
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			offerCoinStr := args[0]
+			offerCoin, err := sdk.ParseCoin(offerCoinStr)
+			if err != nil {
+				return err
+			}
+
+			askDenoms := strings.Split(args[1], ",")
+
+			minAskAmount := sdk.ZeroInt()
+			if minAskAmountStr := viper.GetString(flagMinAskAmount); len(minAskAmountStr) != 0 {
+				var ok bool
+				minAskAmount, ok = sdk.NewIntFromString(minAskAmountStr)
+				if !ok {
+					return fmt.Errorf("given min-ask-amount {%s} is not a valid integer", minAskAmountStr)
+				}
+			}
+
+			fromAddress := cliCtx.GetFromAddress()
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgRouteSwap(fromAddress, offerCoin, askDenoms, minAskAmount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagMinAskAmount, "", "(optional) minimum amount of the last ask coin to receive after the spread fees; the swap fails otherwise")
+
+	return cmd
+}
+
+// GetPlaceLimitOrderCmd will create and send a MsgPlaceLimitOrder
+func GetPlaceLimitOrderCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-limit-order [offer-coin] [ask-denom] [limit-price] [expiry-height]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Place a limit order that swaps once the oracle exchange rate meets the limit price",
+		Long: strings.TrimSpace(`
+Place a limit order that swaps the offer-coin to the ask-denom currency at the end of the first block 
+where the swap, after the spread fee, returns at least limit-price of ask-denom per unit of offer-coin. 
+The offer-coin is escrowed until the order is executed, cancelled, or expires after expiry-height.
+
+$ terracli market place-limit-order "1000000ukrw" "uusd" "0.00085" 1200000
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			offerCoinStr := args[0]
+			offerCoin, err := sdk.ParseCoin(offerCoinStr)
+			if err != nil {
+				return err
+			}
+
+			askDenom := args[1]
+
+			limitPrice, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			expiryHeight, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("given expiry-height {%s} is not a valid integer", args[3])
+			}
+
+			fromAddress := cliCtx.GetFromAddress()
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgPlaceLimitOrder(fromAddress, offerCoin, askDenom, limitPrice, expiryHeight)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCancelLimitOrderCmd will create and send a MsgCancelLimitOrder
+func GetCancelLimitOrderCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-limit-order [order-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a resting limit order and refund its escrowed offer coin",
+		Long: strings.TrimSpace(`
+Cancel a resting limit order placed by the sender, and refund its escrowed offer coin.
+
+$ terracli market cancel-limit-order 12
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("given order-id {%s} is not a valid order ID", args[0])
+			}
+
+			fromAddress := cliCtx.GetFromAddress()
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgCancelLimitOrder(fromAddress, orderID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCreateSwapScheduleCmd will create and send a MsgCreateSwapSchedule
+func GetCreateSwapScheduleCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-swap-schedule [offer-coin] [ask-denom] [interval] [executions]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Create a schedule that swaps a fixed offer coin every interval blocks",
+		Long: strings.TrimSpace(`
+Create a schedule that swaps the offer-coin to the ask-denom currency every interval blocks, executions times. 
+The offer coins of all the executions are escrowed until they are swapped or the schedule is cancelled. 
+An execution is skipped if the swap cannot be made, e.g. when the oracle has no price for a denom.
+
+$ terracli market create-swap-schedule "1000000ukrw" "uusd" 14400 30
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			offerCoinStr := args[0]
+			offerCoin, err := sdk.ParseCoin(offerCoinStr)
+			if err != nil {
+				return err
+			}
+
+			askDenom := args[1]
+
+			interval, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("given interval {%s} is not a valid integer", args[2])
+			}
+
+			executions, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("given executions {%s} is not a valid integer", args[3])
+			}
+
+			fromAddress := cliCtx.GetFromAddress()
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgCreateSwapSchedule(fromAddress, offerCoin, askDenom, interval, executions)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCancelSwapScheduleCmd will create and send a MsgCancelSwapSchedule
+func GetCancelSwapScheduleCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-swap-schedule [schedule-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a swap schedule and refund the escrow of its remaining executions",
+		Long: strings.TrimSpace(`
+Cancel a swap schedule created by the sender, and refund the escrowed offer coins of its remaining executions.
+
+$ terracli market cancel-swap-schedule 3
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			scheduleID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("given schedule-id {%s} is not a valid schedule ID", args[0])
+			}
+
+			fromAddress := cliCtx.GetFromAddress()
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgCancelSwapSchedule(fromAddress, scheduleID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitCircuitBreakerProposal implements the command to submit a circuit-breaker proposal
+func GetCmdSubmitCircuitBreakerProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breaker [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to halt or resume Luna swaps",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a circuit breaker proposal along with an initial deposit.
+The proposal halts Luna swaps if halt is true, and resumes them if halt is false.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal circuit-breaker <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Halt Luna Swaps",
+  "description": "Lets halt Luna swaps until the peg recovers",
+  "halt": true,
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseCircuitBreakerProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewCircuitBreakerProposal(proposal.Title, proposal.Description, proposal.Halt)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitDenomParamsUpdateProposal implements the command to submit a denom-params-update proposal
+func GetCmdSubmitDenomParamsUpdateProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-params-update [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the market param overrides of a denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a denom params update proposal along with an initial deposit.
+The proposal replaces the daily Luna delta cap and swap spread overrides of a single denom,
+or removes them if remove is true. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal denom-params-update <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Update GBP Swap Spread",
+  "description": "Lets charge stricter spreads on ugbp swaps",
+  "denom_params": {
+    "denom": "ugbp",
+    "daily_luna_delta_cap": "0.005",
+    "min_swap_spread": "0.05",
+    "max_swap_spread": "1.0"
+  },
+  "remove": false,
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseDenomParamsUpdateProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewDenomParamsUpdateProposal(proposal.Title, proposal.Description, proposal.DenomParams, proposal.Remove)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
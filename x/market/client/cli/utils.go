@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/market/internal/types"
+)
+
+type (
+	// CircuitBreakerProposalJSON defines a CircuitBreakerProposal with a deposit
+	CircuitBreakerProposalJSON struct {
+		Title       string    `json:"title" yaml:"title"`
+		Description string    `json:"description" yaml:"description"`
+		Halt        bool      `json:"halt" yaml:"halt"`
+		Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	}
+
+	// DenomParamsUpdateProposalJSON defines a DenomParamsUpdateProposal with a deposit
+	DenomParamsUpdateProposalJSON struct {
+		Title       string            `json:"title" yaml:"title"`
+		Description string            `json:"description" yaml:"description"`
+		DenomParams types.DenomParams `json:"denom_params" yaml:"denom_params"`
+		Remove      bool              `json:"remove" yaml:"remove"`
+		Deposit     sdk.Coins         `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// ParseCircuitBreakerProposalJSON reads and parses a CircuitBreakerProposalJSON from a file.
+func ParseCircuitBreakerProposalJSON(cdc *codec.Codec, proposalFile string) (CircuitBreakerProposalJSON, error) {
+	proposal := CircuitBreakerProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseDenomParamsUpdateProposalJSON reads and parses a DenomParamsUpdateProposalJSON from a file.
+func ParseDenomParamsUpdateProposalJSON(cdc *codec.Codec, proposalFile string) (DenomParamsUpdateProposalJSON, error) {
+	proposal := DenomParamsUpdateProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
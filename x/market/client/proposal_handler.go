@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/terra-project/core/x/market/client/cli"
+	"github.com/terra-project/core/x/market/client/rest"
+)
+
+// param change proposal handler
+var (
+	CircuitBreakerProposalHandler    = govclient.NewProposalHandler(cli.GetCmdSubmitCircuitBreakerProposal, rest.CircuitBreakerProposalRESTHandler)
+	DenomParamsUpdateProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitDenomParamsUpdateProposal, rest.DenomParamsUpdateProposalRESTHandler)
+)
@@ -2,14 +2,43 @@ package rest
 
 import (
 	"github.com/cosmos/cosmos-sdk/client/context"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 	"github.com/gorilla/mux"
 )
 
 // RestDenom
 const RestDenom = "denom"
 
+// RestOrderID
+const RestOrderID = "orderID"
+
+// RestScheduleID
+const RestScheduleID = "scheduleID"
+
+// RestEpoch
+const RestEpoch = "epoch"
+
+// RestTrader
+const RestTrader = "trader"
+
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	registerTxRoutes(cliCtx, r)
 	registerQueryRoutes(cliCtx, r)
 }
+
+// CircuitBreakerProposalRESTHandler returns a ProposalRESTHandler that exposes the circuit breaker proposal REST handler with a given sub-route.
+func CircuitBreakerProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "circuit_breaker",
+		Handler:  postCircuitBreakerProposalHandlerFn(cliCtx),
+	}
+}
+
+// DenomParamsUpdateProposalRESTHandler returns a ProposalRESTHandler that exposes the denom params update proposal REST handler with a given sub-route.
+func DenomParamsUpdateProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "denom_params_update",
+		Handler:  postDenomParamsUpdateProposalHandlerFn(cliCtx),
+	}
+}
@@ -1,7 +1,9 @@
 package rest
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/terra-project/core/x/market/internal/types"
 
@@ -11,10 +13,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 )
 
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/market/swap", submitSwapHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/market/swap_send", submitSwapSendHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/market/route_swap", submitRouteSwapHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/market/limit_orders", submitPlaceLimitOrderHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/market/limit_orders/{%s}/cancel", RestOrderID), submitCancelLimitOrderHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/market/swap_schedules", submitCreateSwapScheduleHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/market/swap_schedules/{%s}/cancel", RestScheduleID), submitCancelSwapScheduleHandlerFn(cliCtx)).Methods("POST")
 }
 
 //nolint
@@ -58,3 +67,373 @@ func submitSwapHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+//nolint
+type SwapSendReq struct {
+	BaseReq      rest.BaseReq `json:"base_req"`
+	Recipient    string       `json:"recipient"`
+	OfferCoin    sdk.Coin     `json:"offer_coin"`
+	AskDenom     string       `json:"ask_denom"`
+	MinAskAmount string       `json:"min_ask_amount"`
+}
+
+// submitSwapSendHandlerFn handles a POST swap send request
+func submitSwapSendHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SwapSendReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			err := sdk.ErrUnknownRequest("malformed request")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			err := sdk.ErrUnknownRequest("malformed request")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// the slippage guard is optional
+		minAskAmount := sdk.ZeroInt()
+		if len(req.MinAskAmount) != 0 {
+			var ok bool
+			minAskAmount, ok = sdk.NewIntFromString(req.MinAskAmount)
+			if !ok {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid min_ask_amount: "+req.MinAskAmount)
+				return
+			}
+		}
+
+		// create the message
+		msg := types.NewMsgSwapSend(fromAddress, recipient, req.OfferCoin, req.AskDenom, minAskAmount)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+//nolint
+type RouteSwapReq struct {
+	BaseReq      rest.BaseReq `json:"base_req"`
+	OfferCoin    sdk.Coin     `json:"offer_coin"`
+	AskDenoms    []string     `json:"ask_denoms"`
+	MinAskAmount string       `json:"min_ask_amount"`
+}
+
+// submitRouteSwapHandlerFn handles a POST route swap request
+func submitRouteSwapHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RouteSwapReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			err := sdk.ErrUnknownRequest("malformed request")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			err := sdk.ErrUnknownRequest("malformed request")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// the slippage guard is optional
+		minAskAmount := sdk.ZeroInt()
+		if len(req.MinAskAmount) != 0 {
+			var ok bool
+			minAskAmount, ok = sdk.NewIntFromString(req.MinAskAmount)
+			if !ok {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid min_ask_amount: "+req.MinAskAmount)
+				return
+			}
+		}
+
+		// create the message
+		msg := types.NewMsgRouteSwap(fromAddress, req.OfferCoin, req.AskDenoms, minAskAmount)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+//nolint
+type PlaceLimitOrderReq struct {
+	BaseReq      rest.BaseReq `json:"base_req"`
+	OfferCoin    sdk.Coin     `json:"offer_coin"`
+	AskDenom     string       `json:"ask_denom"`
+	LimitPrice   sdk.Dec      `json:"limit_price"`
+	ExpiryHeight int64        `json:"expiry_height"`
+}
+
+// submitPlaceLimitOrderHandlerFn handles a POST place limit order request
+func submitPlaceLimitOrderHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PlaceLimitOrderReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			err := sdk.ErrUnknownRequest("malformed request")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			err := sdk.ErrUnknownRequest("malformed request")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgPlaceLimitOrder(fromAddress, req.OfferCoin, req.AskDenom, req.LimitPrice, req.ExpiryHeight)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+//nolint
+type CancelLimitOrderReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+// submitCancelLimitOrderHandlerFn handles a POST cancel limit order request
+func submitCancelLimitOrderHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		orderID, err := strconv.ParseUint(vars[RestOrderID], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req CancelLimitOrderReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			err := sdk.ErrUnknownRequest("malformed request")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			err := sdk.ErrUnknownRequest("malformed request")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgCancelLimitOrder(fromAddress, orderID)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+//nolint
+type CreateSwapScheduleReq struct {
+	BaseReq    rest.BaseReq `json:"base_req"`
+	OfferCoin  sdk.Coin     `json:"offer_coin"`
+	AskDenom   string       `json:"ask_denom"`
+	Interval   int64        `json:"interval"`
+	Executions int64        `json:"executions"`
+}
+
+// submitCreateSwapScheduleHandlerFn handles a POST create swap schedule request
+func submitCreateSwapScheduleHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateSwapScheduleReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			err := sdk.ErrUnknownRequest("malformed request")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			err := sdk.ErrUnknownRequest("malformed request")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgCreateSwapSchedule(fromAddress, req.OfferCoin, req.AskDenom, req.Interval, req.Executions)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+//nolint
+type CancelSwapScheduleReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
+// submitCancelSwapScheduleHandlerFn handles a POST cancel swap schedule request
+func submitCancelSwapScheduleHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		scheduleID, err := strconv.ParseUint(vars[RestScheduleID], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req CancelSwapScheduleReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			err := sdk.ErrUnknownRequest("malformed request")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			err := sdk.ErrUnknownRequest("malformed request")
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddress, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := types.NewMsgCancelSwapSchedule(fromAddress, scheduleID)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// CircuitBreakerProposalReq defines a circuit-breaker proposal request body
+type CircuitBreakerProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Halt        bool           `json:"halt" yaml:"halt"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// postCircuitBreakerProposalHandlerFn handles a POST circuit breaker proposal request
+func postCircuitBreakerProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CircuitBreakerProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCircuitBreakerProposal(req.Title, req.Description, req.Halt)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// DenomParamsUpdateProposalReq defines a denom-params-update proposal request body
+type DenomParamsUpdateProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string            `json:"title" yaml:"title"`
+	Description string            `json:"description" yaml:"description"`
+	DenomParams types.DenomParams `json:"denom_params" yaml:"denom_params"`
+	Remove      bool              `json:"remove" yaml:"remove"`
+	Proposer    sdk.AccAddress    `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins         `json:"deposit" yaml:"deposit"`
+}
+
+// postDenomParamsUpdateProposalHandlerFn handles a POST denom params update proposal request
+func postDenomParamsUpdateProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req DenomParamsUpdateProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewDenomParamsUpdateProposal(req.Title, req.Description, req.DenomParams, req.Remove)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
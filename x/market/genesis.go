@@ -8,6 +8,42 @@ import (
 // and the keeper's address to pubkey map
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	keeper.SetParams(ctx, data.Params)
+	keeper.SetTerraPoolDelta(ctx, data.TerraPoolDelta)
+
+	// next order ID follows the last exported order
+	nextOrderID := uint64(1)
+	for _, order := range data.LimitOrders {
+		keeper.SetLimitOrder(ctx, order)
+
+		if order.OrderID >= nextOrderID {
+			nextOrderID = order.OrderID + 1
+		}
+	}
+
+	keeper.SetNextLimitOrderID(ctx, nextOrderID)
+	keeper.SetLimitOrderCursor(ctx, data.LimitOrderCursor)
+
+	for _, bucket := range data.IssuanceBuckets {
+		keeper.SetIssuanceBucket(ctx, bucket)
+	}
+
+	keeper.SetSwapHalted(ctx, data.SwapHalted)
+
+	if !data.CircuitBreakerReset.Issuance.Empty() {
+		keeper.SetCircuitBreakerReset(ctx, data.CircuitBreakerReset)
+	}
+
+	// next schedule ID follows the last exported schedule
+	nextScheduleID := uint64(1)
+	for _, schedule := range data.SwapSchedules {
+		keeper.SetSwapSchedule(ctx, schedule)
+
+		if schedule.ScheduleID >= nextScheduleID {
+			nextScheduleID = schedule.ScheduleID + 1
+		}
+	}
+
+	keeper.SetNextSwapScheduleID(ctx, nextScheduleID)
 }
 
 // ExportGenesis writes the current store values
@@ -15,6 +51,28 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
 	params := keeper.GetParams(ctx)
+	terraPoolDelta := keeper.GetTerraPoolDelta(ctx)
+
+	limitOrders := []LimitOrder{}
+	keeper.IterateLimitOrders(ctx, func(order LimitOrder) (stop bool) {
+		limitOrders = append(limitOrders, order)
+		return false
+	})
+
+	limitOrderCursor := keeper.GetLimitOrderCursor(ctx)
+
+	// A store of the previous version still holds its issuance snapshot; export it as a bucket
+	keeper.MigratePrevDayIssuance(ctx)
+	issuanceBuckets := keeper.GetIssuanceBuckets(ctx)
+	swapHalted := keeper.GetSwapHalted(ctx)
+
+	circuitBreakerReset, _ := keeper.GetCircuitBreakerReset(ctx)
+
+	swapSchedules := []SwapSchedule{}
+	keeper.IterateSwapSchedules(ctx, func(schedule SwapSchedule) (stop bool) {
+		swapSchedules = append(swapSchedules, schedule)
+		return false
+	})
 
-	return NewGenesisState(params)
+	return NewGenesisState(params, terraPoolDelta, limitOrders, limitOrderCursor, issuanceBuckets, swapHalted, swapSchedules, circuitBreakerReset)
 }
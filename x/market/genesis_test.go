@@ -0,0 +1,30 @@
+package market
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/market/internal/keeper"
+)
+
+func TestExportImportLimitOrderCursor(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	for i := 0; i < 3; i++ {
+		input.MarketKeeper.AddLimitOrder(input.Ctx, NewLimitOrder(0, keeper.Addrs[0], offerCoin, core.MicroSDRDenom, sdk.OneDec(), 10))
+	}
+	input.MarketKeeper.SetLimitOrderCursor(input.Ctx, 2)
+
+	genesis := ExportGenesis(input.Ctx, input.MarketKeeper)
+	require.Equal(t, uint64(2), genesis.LimitOrderCursor)
+
+	// matching resumes from the same order after the import
+	newInput := keeper.CreateTestInput(t)
+	InitGenesis(newInput.Ctx, newInput.MarketKeeper, genesis)
+	require.Equal(t, uint64(2), newInput.MarketKeeper.GetLimitOrderCursor(newInput.Ctx))
+	require.Equal(t, uint64(4), newInput.MarketKeeper.GetNextLimitOrderID(newInput.Ctx))
+}
@@ -1,9 +1,12 @@
 package market
 
 import (
+	"fmt"
 	"reflect"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
 	"github.com/terra-project/core/x/market/internal/types"
 )
 
@@ -13,6 +16,18 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgSwap:
 			return handleMsgSwap(ctx, k, msg)
+		case MsgSwapSend:
+			return handleMsgSwapSend(ctx, k, msg)
+		case MsgRouteSwap:
+			return handleMsgRouteSwap(ctx, k, msg)
+		case MsgPlaceLimitOrder:
+			return handleMsgPlaceLimitOrder(ctx, k, msg)
+		case MsgCancelLimitOrder:
+			return handleMsgCancelLimitOrder(ctx, k, msg)
+		case MsgCreateSwapSchedule:
+			return handleMsgCreateSwapSchedule(ctx, k, msg)
+		case MsgCancelSwapSchedule:
+			return handleMsgCancelSwapSchedule(ctx, k, msg)
 		default:
 			errMsg := "Unrecognized market Msg type: " + reflect.TypeOf(msg).Name()
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -22,60 +37,203 @@ func NewHandler(k Keeper) sdk.Handler {
 
 // handleMsgSwap handles the logic of a MsgSwap
 func handleMsgSwap(ctx sdk.Context, k Keeper, ms MsgSwap) sdk.Result {
+	return handleSwapRequest(ctx, k, ms.Trader, ms.Trader, ms.OfferCoin, ms.AskDenom, sdk.ZeroInt())
+}
+
+// handleMsgSwapSend handles the logic of a MsgSwapSend
+func handleMsgSwapSend(ctx sdk.Context, k Keeper, mss MsgSwapSend) sdk.Result {
+	return handleSwapRequest(ctx, k, mss.Trader, mss.Recipient, mss.OfferCoin, mss.AskDenom, mss.MinAskAmount)
+}
+
+// handleSwapRequest swaps the trader's offer coin to the ask denom and credits the recipient;
+// the swap fails if the swapped coin after the spread fee is less than minAskAmount
+func handleSwapRequest(ctx sdk.Context, k Keeper,
+	trader sdk.AccAddress, recipient sdk.AccAddress,
+	offerCoin sdk.Coin, askDenom string, minAskAmount sdk.Int) sdk.Result {
 
-	// Can't swap to the same coin
-	if ms.OfferCoin.Denom == ms.AskDenom {
-		return ErrRecursiveSwap(DefaultCodespace, ms.AskDenom).Result()
+	// Send offer coins from the trader's account to module account
+	fundSwap := func(ctx sdk.Context) sdk.Error {
+		return k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, trader, ModuleName, sdk.NewCoins(offerCoin))
 	}
 
-	// Compute exchange rates between the ask and offer
-	swapCoin, spread, swapErr := k.GetSwapCoin(ctx, ms.OfferCoin, ms.AskDenom, false)
-	if swapErr != nil {
-		return swapErr.Result()
+	hop, err := executeSwap(ctx, k, trader, recipient, offerCoin, askDenom, minAskAmount, fundSwap)
+	if err != nil {
+		return err.Result()
 	}
 
-	// Send offer coins to module account
-	offerCoins := sdk.NewCoins(ms.OfferCoin)
-	err := k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, ms.Trader, ModuleName, offerCoins)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newSwapEvent(trader, recipient, hop),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// executeSwap swaps the offer coin to the ask denom and credits the recipient; fundSwap moves the offer coin
+// into the module account once the swap is known to meet minAskAmount
+func executeSwap(ctx sdk.Context, k Keeper,
+	trader sdk.AccAddress, recipient sdk.AccAddress,
+	offerCoin sdk.Coin, askDenom string, minAskAmount sdk.Int,
+	fundSwap func(ctx sdk.Context) sdk.Error) (hop SwapHop, err sdk.Error) {
+
+	// Let the registered hooks veto the swap
+	err = k.BeforeSwap(ctx, trader, offerCoin, askDenom)
+	if err != nil {
+		return
+	}
+
+	// Compute the swap with the spread fee charged, and update the virtual pools
+	hop, err = k.ApplySwap(ctx, offerCoin, askDenom)
+	if err != nil {
+		return
+	}
+
+	// Fail before touching any balances if the trader would receive less than asked for
+	if hop.SwapCoin.Amount.LT(minAskAmount) {
+		err = ErrSlippage(DefaultCodespace, hop.SwapCoin, minAskAmount)
+		return
+	}
+
+	err = fundSwap(ctx)
+	if err != nil {
+		return
+	}
+
+	// Burn offered coins and mint asked coins; the spread fee is minted and distributed to the reward pools
+	err = settleSwapHop(ctx, k, hop)
+	if err != nil {
+		return
+	}
+
+	// Credit the recipient's account
+	err = k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, recipient, sdk.NewCoins(hop.SwapCoin))
+	if err != nil {
+		return
+	}
+
+	k.RecordSwapVolume(ctx, trader, hop.OfferCoin, hop.SwapCoin)
+	k.AfterSwap(ctx, trader, hop.OfferCoin, hop.SwapCoin, hop.SwapFee)
+
+	return hop, nil
+}
+
+// handleMsgRouteSwap handles the logic of a MsgRouteSwap
+func handleMsgRouteSwap(ctx sdk.Context, k Keeper, mrs MsgRouteSwap) sdk.Result {
+
+	// Send offer coins to module account; the intermediate coins of the route never leave it
+	err := k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, mrs.Trader, ModuleName, sdk.NewCoins(mrs.OfferCoin))
 	if err != nil {
 		return err.Result()
 	}
 
-	// Charge a spread if applicable; distributed to vote winners in the oracle module
-	var swapFee sdk.Coin
-	if spread.IsPositive() {
-		swapFeeAmt := spread.MulInt(swapCoin.Amount).TruncateInt()
-		if swapFeeAmt.IsPositive() {
-			swapFee = sdk.NewCoin(swapCoin.Denom, swapFeeAmt)
-			swapCoin = swapCoin.Sub(swapFee)
+	var hops SwapHops
+	var events sdk.Events
+	offerCoin := mrs.OfferCoin
+	for _, askDenom := range mrs.AskDenoms {
+		hookErr := k.BeforeSwap(ctx, mrs.Trader, offerCoin, askDenom)
+		if hookErr != nil {
+			return hookErr.Result()
 		}
-	}
 
-	// Burn offered coins and subtract from the trader's account
-	burnErr := k.SupplyKeeper.BurnCoins(ctx, ModuleName, offerCoins)
-	if burnErr != nil {
-		return burnErr.Result()
+		hop, swapErr := k.ApplySwap(ctx, offerCoin, askDenom)
+		if swapErr != nil {
+			return swapErr.Result()
+		}
+
+		settleErr := settleSwapHop(ctx, k, hop)
+		if settleErr != nil {
+			return settleErr.Result()
+		}
+
+		hops = append(hops, hop)
+		events = append(events, newSwapEvent(mrs.Trader, mrs.Trader, hop))
+		offerCoin = hop.SwapCoin
 	}
 
-	// Mint asked coins and credit Trader's account
-	swapCoins := sdk.NewCoins(swapCoin)
-	mintErr := k.SupplyKeeper.MintCoins(ctx, ModuleName, swapCoins)
-	if mintErr != nil {
-		return mintErr.Result()
+	// Fail if the trader would receive less than asked for
+	if offerCoin.Amount.LT(mrs.MinAskAmount) {
+		return ErrSlippage(DefaultCodespace, offerCoin, mrs.MinAskAmount).Result()
 	}
 
-	sendErr := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, ms.Trader, swapCoins)
+	// Credit the trader's account with the coin of the last hop
+	sendErr := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, mrs.Trader, sdk.NewCoins(offerCoin))
 	if sendErr != nil {
 		return sendErr.Result()
 	}
 
+	for _, hop := range hops {
+		k.RecordSwapVolume(ctx, mrs.Trader, hop.OfferCoin, hop.SwapCoin)
+		k.AfterSwap(ctx, mrs.Trader, hop.OfferCoin, hop.SwapCoin, hop.SwapFee)
+	}
+
+	ctx.EventManager().EmitEvents(append(events,
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	))
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handleMsgPlaceLimitOrder handles the logic of a MsgPlaceLimitOrder
+func handleMsgPlaceLimitOrder(ctx sdk.Context, k Keeper, mplo MsgPlaceLimitOrder) sdk.Result {
+	if mplo.ExpiryHeight <= ctx.BlockHeight() {
+		return ErrInvalidExpiryHeight(DefaultCodespace, mplo.ExpiryHeight, ctx.BlockHeight()).Result()
+	}
+
+	// Escrow offer coins in the module account until the order is closed
+	err := k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, mplo.Trader, ModuleName, sdk.NewCoins(mplo.OfferCoin))
+	if err != nil {
+		return err.Result()
+	}
+
+	order := k.AddLimitOrder(ctx, NewLimitOrder(0, mplo.Trader, mplo.OfferCoin, mplo.AskDenom, mplo.LimitPrice, mplo.ExpiryHeight))
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
-			types.EventSwap,
-			sdk.NewAttribute(types.AttributeKeyOffer, ms.OfferCoin.String()),
-			sdk.NewAttribute(types.AttributeKeyTrader, ms.Trader.String()),
-			sdk.NewAttribute(types.AttributeKeySwapCoin, swapCoin.String()),
-			sdk.NewAttribute(types.AttributeKeySwapFee, swapFee.String()),
+			types.EventPlaceLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.OrderID)),
+			sdk.NewAttribute(types.AttributeKeyTrader, order.Trader.String()),
+			sdk.NewAttribute(types.AttributeKeyOffer, order.OfferCoin.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Data: ModuleCdc.MustMarshalBinaryLengthPrefixed(order.OrderID), Events: ctx.EventManager().Events()}
+}
+
+// handleMsgCancelLimitOrder handles the logic of a MsgCancelLimitOrder
+func handleMsgCancelLimitOrder(ctx sdk.Context, k Keeper, mclo MsgCancelLimitOrder) sdk.Result {
+	order, err := k.GetLimitOrder(ctx, mclo.OrderID)
+	if err != nil {
+		return err.Result()
+	}
+
+	if !order.Trader.Equals(mclo.Trader) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("limit order %d is not owned by %s", order.OrderID, mclo.Trader)).Result()
+	}
+
+	// Refund the escrowed offer coins
+	err = k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, order.Trader, sdk.NewCoins(order.OfferCoin))
+	if err != nil {
+		return err.Result()
+	}
+
+	k.DeleteLimitOrder(ctx, order.OrderID)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventCancelLimitOrder,
+			sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", order.OrderID)),
+			sdk.NewAttribute(types.AttributeKeyTrader, order.Trader.String()),
+			sdk.NewAttribute(types.AttributeKeyOffer, order.OfferCoin.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -85,3 +243,152 @@ func handleMsgSwap(ctx sdk.Context, k Keeper, ms MsgSwap) sdk.Result {
 
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+// handleMsgCreateSwapSchedule handles the logic of a MsgCreateSwapSchedule
+func handleMsgCreateSwapSchedule(ctx sdk.Context, k Keeper, mcss MsgCreateSwapSchedule) sdk.Result {
+	for _, denom := range []string{mcss.OfferCoin.Denom, mcss.AskDenom} {
+		if err := k.ValidateScheduleDenom(ctx, denom); err != nil {
+			return err.Result()
+		}
+	}
+
+	schedule := NewSwapSchedule(0, mcss.Trader, mcss.OfferCoin, mcss.AskDenom,
+		mcss.Interval, mcss.Executions, ctx.BlockHeight()+mcss.Interval)
+
+	// Escrow the offer coins of all the executions until they are swapped or the schedule is cancelled
+	err := k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, mcss.Trader, ScheduleEscrowName, sdk.NewCoins(schedule.Escrow()))
+	if err != nil {
+		return err.Result()
+	}
+
+	schedule = k.AddSwapSchedule(ctx, schedule)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventCreateSwapSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", schedule.ScheduleID)),
+			sdk.NewAttribute(types.AttributeKeyTrader, schedule.Trader.String()),
+			sdk.NewAttribute(types.AttributeKeyOffer, schedule.OfferCoin.String()),
+			sdk.NewAttribute(types.AttributeKeyNextHeight, fmt.Sprintf("%d", schedule.NextHeight)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Data: ModuleCdc.MustMarshalBinaryLengthPrefixed(schedule.ScheduleID), Events: ctx.EventManager().Events()}
+}
+
+// handleMsgCancelSwapSchedule handles the logic of a MsgCancelSwapSchedule
+func handleMsgCancelSwapSchedule(ctx sdk.Context, k Keeper, mcss MsgCancelSwapSchedule) sdk.Result {
+	schedule, err := k.GetSwapSchedule(ctx, mcss.ScheduleID)
+	if err != nil {
+		return err.Result()
+	}
+
+	if !schedule.Trader.Equals(mcss.Trader) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("swap schedule %d is not owned by %s", schedule.ScheduleID, mcss.Trader)).Result()
+	}
+
+	// Refund the escrowed offer coins of the remaining executions
+	err = k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, ScheduleEscrowName, schedule.Trader, sdk.NewCoins(schedule.Escrow()))
+	if err != nil {
+		return err.Result()
+	}
+
+	k.DeleteSwapSchedule(ctx, schedule.ScheduleID)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventCancelSwapSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", schedule.ScheduleID)),
+			sdk.NewAttribute(types.AttributeKeyTrader, schedule.Trader.String()),
+			sdk.NewAttribute(types.AttributeKeyOffer, schedule.Escrow().String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// settleSwapHop burns the offer coin of the hop held by the module account, mints the swap coin and
+// the swap fee into it, and distributes the swap fee to the oracle reward pool and the community pool
+func settleSwapHop(ctx sdk.Context, k Keeper, hop SwapHop) sdk.Error {
+	burnErr := k.SupplyKeeper.BurnCoins(ctx, ModuleName, sdk.NewCoins(hop.OfferCoin))
+	if burnErr != nil {
+		return burnErr
+	}
+
+	mintErr := k.SupplyKeeper.MintCoins(ctx, ModuleName, sdk.NewCoins(hop.SwapCoin).Add(sdk.NewCoins(hop.SwapFee)))
+	if mintErr != nil {
+		return mintErr
+	}
+
+	return k.DistributeSwapFee(ctx, hop.SwapFee)
+}
+
+func newSwapEvent(trader sdk.AccAddress, recipient sdk.AccAddress, hop SwapHop) sdk.Event {
+	return sdk.NewEvent(
+		types.EventSwap,
+		sdk.NewAttribute(types.AttributeKeyOffer, hop.OfferCoin.String()),
+		sdk.NewAttribute(types.AttributeKeyTrader, trader.String()),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+		sdk.NewAttribute(types.AttributeKeySwapCoin, hop.SwapCoin.String()),
+		sdk.NewAttribute(types.AttributeKeySwapFee, hop.SwapFee.String()),
+	)
+}
+
+// NewMarketPolicyUpdateHandler creates a new handler for market governance proposals
+func NewMarketPolicyUpdateHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case CircuitBreakerProposal:
+			return handleCircuitBreakerProposal(ctx, k, c)
+		case DenomParamsUpdateProposal:
+			return handleDenomParamsUpdateProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized market proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+// handleCircuitBreakerProposal is a handler for tripping or resetting the circuit breaker on Luna swaps;
+// a reset also clears a trip on the Luna supply change, by measuring the change from the current issuance
+func handleCircuitBreakerProposal(ctx sdk.Context, k Keeper, p CircuitBreakerProposal) sdk.Error {
+	if p.Halt {
+		k.SetSwapHalted(ctx, true)
+	} else {
+		k.ResetCircuitBreaker(ctx)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventCircuitBreaker,
+			sdk.NewAttribute(types.AttributeKeyHalted, fmt.Sprintf("%t", p.Halt)),
+		),
+	)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("updated luna swap halted to %t", p.Halt))
+	return nil
+}
+
+// handleDenomParamsUpdateProposal is a handler for updating the param overrides of a single denom
+func handleDenomParamsUpdateProposal(ctx sdk.Context, k Keeper, p DenomParamsUpdateProposal) sdk.Error {
+	logger := k.Logger(ctx)
+	if p.Remove {
+		k.DeleteDenomParams(ctx, p.DenomParams.Denom)
+		logger.Info(fmt.Sprintf("removed denom params of %s", p.DenomParams.Denom))
+		return nil
+	}
+
+	k.SetDenomParams(ctx, p.DenomParams)
+	logger.Info(fmt.Sprintf("updated denom params to %s", p.DenomParams))
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 
 	core "github.com/terra-project/core/types"
 	"github.com/terra-project/core/x/market/internal/keeper"
+	"github.com/terra-project/core/x/oracle"
 )
 
 func TestMarketFilters(t *testing.T) {
@@ -35,3 +36,253 @@ func TestSwapMsg(t *testing.T) {
 	res := h(input.Ctx, prevoteMsg)
 	require.True(t, res.IsOK())
 }
+
+func TestSwapMsgDistributesFee(t *testing.T) {
+	input, h := setup(t)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(1000000))
+	expectedSwapCoin, spread, err := input.MarketKeeper.GetSwapCoin(input.Ctx, offerCoin, core.MicroSDRDenom, false)
+	require.NoError(t, err)
+
+	swapFeeAmt := spread.MulInt(expectedSwapCoin.Amount).TruncateInt()
+	require.True(t, swapFeeAmt.IsPositive())
+
+	swapMsg := NewMsgSwap(keeper.Addrs[0], offerCoin, core.MicroSDRDenom)
+	res := h(input.Ctx, swapMsg)
+	require.True(t, res.IsOK())
+
+	// the whole fee goes to the oracle reward pool by default
+	oracleAcc := input.SupplyKeeper.GetModuleAccount(input.Ctx, oracle.ModuleName)
+	require.Equal(t, swapFeeAmt, oracleAcc.GetCoins().AmountOf(core.MicroSDRDenom))
+
+	proceeds := input.MarketKeeper.PeekSwapFeeProceeds(input.Ctx, core.GetEpoch(input.Ctx))
+	require.Equal(t, swapFeeAmt, proceeds.AmountOf(core.MicroSDRDenom))
+}
+
+func TestSwapMsgRecordsVolume(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.TraderVolume = true
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(1000000))
+	expectedSwapCoin, _, err := input.MarketKeeper.GetSwapCoin(input.Ctx, offerCoin, core.MicroSDRDenom, false)
+	require.NoError(t, err)
+
+	swapMsg := NewMsgSwap(keeper.Addrs[0], offerCoin, core.MicroSDRDenom)
+	res := h(input.Ctx, swapMsg)
+	require.True(t, res.IsOK())
+
+	epoch := core.GetEpoch(input.Ctx)
+	volume := input.MarketKeeper.PeekSwapVolume(input.Ctx, epoch)
+	require.Equal(t, offerCoin.Amount, volume.AmountOf(core.MicroLunaDenom))
+	require.True(t, volume.AmountOf(core.MicroSDRDenom).IsPositive())
+	require.True(t, volume.AmountOf(core.MicroSDRDenom).LTE(expectedSwapCoin.Amount))
+	require.Equal(t, volume, input.MarketKeeper.PeekTraderSwapVolume(input.Ctx, epoch, keeper.Addrs[0]))
+
+	// a failed swap records nothing
+	swapMsg = NewMsgSwap(keeper.Addrs[0], offerCoin, core.MicroUSDDenom)
+	res = h(input.Ctx, swapMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, volume, input.MarketKeeper.PeekSwapVolume(input.Ctx, epoch))
+}
+
+func TestSwapMsgCircuitBreaker(t *testing.T) {
+	input, h := setup(t)
+	input.MarketKeeper.RecordHourlyIssuance(input.Ctx)
+
+	// swap burning Luna beyond the hard limit is refused
+	issuance := input.SupplyKeeper.GetSupply(input.Ctx).GetTotal().AmountOf(core.MicroLunaDenom)
+	limit := input.MarketKeeper.LunaDeltaHardLimit(input.Ctx)
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, limit.MulInt(issuance).TruncateInt().AddRaw(1))
+	swapMsg := NewMsgSwap(keeper.Addrs[0], offerCoin, core.MicroSDRDenom)
+	res := h(input.Ctx, swapMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, CodeCircuitBreaker, res.Code)
+
+	// swap within the hard limit goes through
+	offerCoin = sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	swapMsg = NewMsgSwap(keeper.Addrs[0], offerCoin, core.MicroSDRDenom)
+	res = h(input.Ctx, swapMsg)
+	require.True(t, res.IsOK())
+}
+
+func TestSwapSendMsg(t *testing.T) {
+	input, h := setup(t)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	expectedSwapCoin, spread, err := input.MarketKeeper.GetSwapCoin(input.Ctx, offerCoin, core.MicroSDRDenom, false)
+	require.NoError(t, err)
+
+	swapFeeAmt := spread.MulInt(expectedSwapCoin.Amount).TruncateInt()
+	expectedReceiveAmt := expectedSwapCoin.Amount.Sub(swapFeeAmt)
+
+	// Case 1: swap fails if the trader would receive less than the minimum ask amount
+	swapSendMsg := NewMsgSwapSend(keeper.Addrs[0], keeper.Addrs[1], offerCoin, core.MicroSDRDenom, expectedReceiveAmt.AddRaw(1))
+	res := h(input.Ctx, swapSendMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, CodeSlippage, res.Code)
+
+	// Case 2: swap goes through and credits the recipient
+	traderBalance := input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins()
+	recipientBalance := input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[1]).GetCoins()
+
+	swapSendMsg = NewMsgSwapSend(keeper.Addrs[0], keeper.Addrs[1], offerCoin, core.MicroSDRDenom, expectedReceiveAmt)
+	res = h(input.Ctx, swapSendMsg)
+	require.True(t, res.IsOK())
+
+	require.Equal(t, traderBalance.AmountOf(core.MicroLunaDenom).Sub(offerCoin.Amount),
+		input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins().AmountOf(core.MicroLunaDenom))
+	require.Equal(t, traderBalance.AmountOf(core.MicroSDRDenom),
+		input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins().AmountOf(core.MicroSDRDenom))
+	require.Equal(t, recipientBalance.AmountOf(core.MicroSDRDenom).Add(expectedReceiveAmt),
+		input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[1]).GetCoins().AmountOf(core.MicroSDRDenom))
+}
+
+func TestRouteSwapMsg(t *testing.T) {
+	input, h := setup(t)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroKRWDenom, randomPrice.MulInt64(1000))
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	askDenoms := []string{core.MicroSDRDenom, core.MicroKRWDenom}
+
+	expectedHops, err := input.MarketKeeper.SimulateRouteSwap(input.Ctx, offerCoin, askDenoms)
+	require.NoError(t, err)
+
+	traderBalance := input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins()
+
+	// asking for more than the last hop swaps to fails
+	cacheCtx, _ := input.Ctx.CacheContext()
+	routeSwapMsg := NewMsgRouteSwap(keeper.Addrs[0], offerCoin, askDenoms, expectedHops[1].SwapCoin.Amount.AddRaw(1))
+	res := h(cacheCtx, routeSwapMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, CodeSlippage, res.Code)
+
+	routeSwapMsg = NewMsgRouteSwap(keeper.Addrs[0], offerCoin, askDenoms, expectedHops[1].SwapCoin.Amount)
+	res = h(input.Ctx, routeSwapMsg)
+	require.True(t, res.IsOK())
+
+	// only the coin of the last hop is credited
+	balance := input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins()
+	require.Equal(t, traderBalance.AmountOf(core.MicroLunaDenom).Sub(offerCoin.Amount), balance.AmountOf(core.MicroLunaDenom))
+	require.Equal(t, traderBalance.AmountOf(core.MicroSDRDenom), balance.AmountOf(core.MicroSDRDenom))
+	require.Equal(t, traderBalance.AmountOf(core.MicroKRWDenom).Add(expectedHops[1].SwapCoin.Amount), balance.AmountOf(core.MicroKRWDenom))
+
+	// no intermediate coins are left in the market module account
+	marketAcc := input.SupplyKeeper.GetModuleAccount(input.Ctx, ModuleName)
+	require.True(t, marketAcc.GetCoins().Empty())
+
+	// a failing hop reverts the route swap
+	routeSwapMsg = NewMsgRouteSwap(keeper.Addrs[0], offerCoin, []string{core.MicroSDRDenom, core.MicroUSDDenom}, sdk.ZeroInt())
+	res = h(input.Ctx, routeSwapMsg)
+	require.False(t, res.IsOK())
+}
+
+func TestLimitOrderMsgs(t *testing.T) {
+	input, h := setup(t)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	traderBalance := input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins()
+
+	// Case 1: order expiring at or before the current height fails
+	input.Ctx = input.Ctx.WithBlockHeight(10)
+	placeMsg := NewMsgPlaceLimitOrder(keeper.Addrs[0], offerCoin, core.MicroSDRDenom, sdk.OneDec(), 10)
+	res := h(input.Ctx, placeMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, CodeInvalidExpiry, res.Code)
+
+	// Case 2: placed order escrows the offer coin
+	placeMsg = NewMsgPlaceLimitOrder(keeper.Addrs[0], offerCoin, core.MicroSDRDenom, sdk.OneDec(), 11)
+	res = h(input.Ctx, placeMsg)
+	require.True(t, res.IsOK())
+
+	var orderID uint64
+	ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &orderID)
+	order, err := input.MarketKeeper.GetLimitOrder(input.Ctx, orderID)
+	require.NoError(t, err)
+	require.Equal(t, offerCoin, order.OfferCoin)
+
+	require.Equal(t, traderBalance.AmountOf(core.MicroLunaDenom).Sub(offerCoin.Amount),
+		input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins().AmountOf(core.MicroLunaDenom))
+	require.Equal(t, sdk.NewCoins(offerCoin), input.SupplyKeeper.GetModuleAccount(input.Ctx, ModuleName).GetCoins())
+
+	// Case 3: order can only be cancelled by its trader
+	cancelMsg := NewMsgCancelLimitOrder(keeper.Addrs[1], orderID)
+	res = h(input.Ctx, cancelMsg)
+	require.False(t, res.IsOK())
+
+	// Case 4: cancelled order refunds the escrow
+	cancelMsg = NewMsgCancelLimitOrder(keeper.Addrs[0], orderID)
+	res = h(input.Ctx, cancelMsg)
+	require.True(t, res.IsOK())
+
+	_, err = input.MarketKeeper.GetLimitOrder(input.Ctx, orderID)
+	require.Error(t, err)
+	require.Equal(t, traderBalance, input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins())
+
+	// Case 5: cancelling a closed order fails
+	res = h(input.Ctx, cancelMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, CodeNoLimitOrder, res.Code)
+}
+
+func TestSwapScheduleMsgs(t *testing.T) {
+	input, h := setup(t)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	traderBalance := input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins()
+
+	// Case 1: created schedule escrows the offer coins of all the executions
+	input.Ctx = input.Ctx.WithBlockHeight(10)
+	createMsg := NewMsgCreateSwapSchedule(keeper.Addrs[0], offerCoin, core.MicroSDRDenom, 5, 3)
+	res := h(input.Ctx, createMsg)
+	require.True(t, res.IsOK())
+
+	var scheduleID uint64
+	ModuleCdc.MustUnmarshalBinaryLengthPrefixed(res.Data, &scheduleID)
+	schedule, err := input.MarketKeeper.GetSwapSchedule(input.Ctx, scheduleID)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), schedule.Remaining)
+	require.Equal(t, int64(15), schedule.NextHeight)
+
+	escrow := sdk.NewCoin(core.MicroLunaDenom, offerCoin.Amount.MulRaw(3))
+	require.Equal(t, traderBalance.AmountOf(core.MicroLunaDenom).Sub(escrow.Amount),
+		input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins().AmountOf(core.MicroLunaDenom))
+	require.Equal(t, sdk.NewCoins(escrow), input.SupplyKeeper.GetModuleAccount(input.Ctx, ScheduleEscrowName).GetCoins())
+
+	// Case 2: schedule can only be cancelled by its trader
+	cancelMsg := NewMsgCancelSwapSchedule(keeper.Addrs[1], scheduleID)
+	res = h(input.Ctx, cancelMsg)
+	require.False(t, res.IsOK())
+
+	// Case 3: cancelled schedule refunds the escrow
+	cancelMsg = NewMsgCancelSwapSchedule(keeper.Addrs[0], scheduleID)
+	res = h(input.Ctx, cancelMsg)
+	require.True(t, res.IsOK())
+
+	_, err = input.MarketKeeper.GetSwapSchedule(input.Ctx, scheduleID)
+	require.Error(t, err)
+	require.Equal(t, traderBalance, input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins())
+
+	// Case 4: cancelling a closed schedule fails
+	res = h(input.Ctx, cancelMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, CodeNoSwapSchedule, res.Code)
+
+	// Case 5: schedule swapping to a denom the oracle has no price for is refused
+	createMsg = NewMsgCreateSwapSchedule(keeper.Addrs[0], offerCoin, core.MicroKRWDenom, 5, 3)
+	res = h(input.Ctx, createMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, CodeNoEffectivePrice, res.Code)
+
+	// Case 6: schedule swapping to a denom off the oracle whitelist is refused, even with a price
+	oracleParams := input.OracleKeeper.GetParams(input.Ctx)
+	oracleParams.Whitelist = oracle.DenomList{core.MicroSDRDenom}
+	input.OracleKeeper.SetParams(input.Ctx, oracleParams)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroGBPDenom, sdk.NewDec(1))
+	createMsg = NewMsgCreateSwapSchedule(keeper.Addrs[0], offerCoin, core.MicroGBPDenom, 5, 3)
+	res = h(input.Ctx, createMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, CodeNoEffectivePrice, res.Code)
+}
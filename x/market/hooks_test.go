@@ -0,0 +1,119 @@
+package market
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/market/internal/keeper"
+)
+
+// mockMarketHooks records the swaps it observes, and vetoes swaps to vetoDenom
+type mockMarketHooks struct {
+	vetoDenom string
+	swaps     []SwapHop
+}
+
+func (h *mockMarketHooks) BeforeSwap(ctx sdk.Context, trader sdk.AccAddress, offerCoin sdk.Coin, askDenom string) sdk.Error {
+	if askDenom == h.vetoDenom {
+		return sdk.ErrUnauthorized("vetoed")
+	}
+
+	return nil
+}
+
+func (h *mockMarketHooks) AfterSwap(ctx sdk.Context, trader sdk.AccAddress, offerCoin sdk.Coin, swapCoin sdk.Coin, swapFee sdk.Coin) {
+	h.swaps = append(h.swaps, NewSwapHop(offerCoin, swapCoin, swapFee))
+}
+
+func setupWithHooks(t *testing.T, hooks MarketHooks) (keeper.TestInput, sdk.Handler) {
+	input, _ := setup(t)
+	input.MarketKeeper = *input.MarketKeeper.SetHooks(hooks)
+
+	return input, NewHandler(input.MarketKeeper)
+}
+
+func TestSwapMsgHooks(t *testing.T) {
+	hooks := &mockMarketHooks{vetoDenom: core.MicroKRWDenom}
+	input, h := setupWithHooks(t, NewMultiMarketHooks(hooks))
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroKRWDenom, randomPrice.MulInt64(1000))
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	cacheCtx, _ := input.Ctx.CacheContext()
+	expectedHop, err := input.MarketKeeper.ApplySwap(cacheCtx, offerCoin, core.MicroSDRDenom)
+	require.NoError(t, err)
+
+	res := h(input.Ctx, NewMsgSwap(keeper.Addrs[0], offerCoin, core.MicroSDRDenom))
+	require.True(t, res.IsOK())
+	require.Equal(t, []SwapHop{expectedHop}, hooks.swaps)
+
+	// vetoed swap leaves the balances untouched
+	traderBalance := input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins()
+	res = h(input.Ctx, NewMsgSwapSend(keeper.Addrs[0], keeper.Addrs[1], offerCoin, core.MicroKRWDenom, sdk.ZeroInt()))
+	require.False(t, res.IsOK())
+	require.Equal(t, sdk.CodeUnauthorized, res.Code)
+	require.Equal(t, traderBalance, input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins())
+	require.Len(t, hooks.swaps, 1)
+}
+
+func TestRouteSwapMsgHooks(t *testing.T) {
+	hooks := &mockMarketHooks{}
+	input, h := setupWithHooks(t, hooks)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroKRWDenom, randomPrice.MulInt64(1000))
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	askDenoms := []string{core.MicroSDRDenom, core.MicroKRWDenom}
+	expectedHops, err := input.MarketKeeper.SimulateRouteSwap(input.Ctx, offerCoin, askDenoms)
+	require.NoError(t, err)
+
+	// every hop is reported
+	res := h(input.Ctx, NewMsgRouteSwap(keeper.Addrs[0], offerCoin, askDenoms, sdk.ZeroInt()))
+	require.True(t, res.IsOK())
+	require.Equal(t, []SwapHop(expectedHops), hooks.swaps)
+
+	// any vetoed hop fails the route swap
+	hooks.vetoDenom = core.MicroKRWDenom
+	cacheCtx, _ := input.Ctx.CacheContext()
+	res = h(cacheCtx, NewMsgRouteSwap(keeper.Addrs[0], offerCoin, askDenoms, sdk.ZeroInt()))
+	require.False(t, res.IsOK())
+}
+
+func TestLimitOrderHooks(t *testing.T) {
+	hooks := &mockMarketHooks{vetoDenom: core.MicroKRWDenom}
+	input, _ := setupWithHooks(t, hooks)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroKRWDenom, randomPrice.MulInt64(1000))
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	escrow := sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, offerCoin.Amount.MulRaw(2)))
+	require.NoError(t, input.SupplyKeeper.SendCoinsFromAccountToModule(input.Ctx, keeper.Addrs[0], ModuleName, escrow))
+
+	// both orders are met at the current prices; the swap of order 2 is vetoed
+	input.MarketKeeper.AddLimitOrder(input.Ctx, NewLimitOrder(0, keeper.Addrs[0], offerCoin, core.MicroSDRDenom, sdk.OneDec(), 5))
+	input.MarketKeeper.AddLimitOrder(input.Ctx, NewLimitOrder(0, keeper.Addrs[0], offerCoin, core.MicroKRWDenom, sdk.OneDec(), 5))
+
+	cacheCtx, _ := input.Ctx.CacheContext()
+	expectedHop, err := input.MarketKeeper.ApplySwap(cacheCtx, offerCoin, core.MicroSDRDenom)
+	require.NoError(t, err)
+
+	traderBalance := input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins()
+	EndBlocker(input.Ctx.WithBlockHeight(1), input.MarketKeeper)
+
+	_, err = input.MarketKeeper.GetLimitOrder(input.Ctx, 1)
+	require.Error(t, err)
+	require.Equal(t, []SwapHop{expectedHop}, hooks.swaps)
+
+	// vetoed order rests with its escrow
+	_, err = input.MarketKeeper.GetLimitOrder(input.Ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(offerCoin), input.SupplyKeeper.GetModuleAccount(input.Ctx, ModuleName).GetCoins())
+	require.True(t, input.AccKeeper.GetAccount(input.Ctx, keeper.Addrs[0]).GetCoins().AmountOf(core.MicroKRWDenom).
+		Equal(traderBalance.AmountOf(core.MicroKRWDenom)))
+}
+
+func TestSetHooksTwice(t *testing.T) {
+	input, _ := setupWithHooks(t, NewMultiMarketHooks())
+	require.Panics(t, func() { input.MarketKeeper.SetHooks(NewMultiMarketHooks()) })
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/market/internal/types"
+)
+
+// GetSwapHalted returns whether governance has halted Luna swaps
+func (k Keeper) GetSwapHalted(ctx sdk.Context) (halted bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.SwapHaltedKey)
+	if bz == nil {
+		return false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &halted)
+	return
+}
+
+// SetSwapHalted sets whether Luna swaps are halted by governance
+func (k Keeper) SetSwapHalted(ctx sdk.Context, halted bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !halted {
+		store.Delete(types.SwapHaltedKey)
+		return
+	}
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(halted)
+	store.Set(types.SwapHaltedKey, bz)
+}
+
+// GetCircuitBreakerReset returns the total issuance at the last governance reset of the circuit breaker
+func (k Keeper) GetCircuitBreakerReset(ctx sdk.Context) (reset types.IssuanceBucket, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CircuitBreakerResetKey)
+	if bz == nil {
+		return types.IssuanceBucket{}, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &reset)
+	return reset, true
+}
+
+// SetCircuitBreakerReset stores the total issuance at the last governance reset of the circuit breaker
+func (k Keeper) SetCircuitBreakerReset(ctx sdk.Context, reset types.IssuanceBucket) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(reset)
+	store.Set(types.CircuitBreakerResetKey, bz)
+}
+
+// ResetCircuitBreaker resumes Luna swaps; the Luna supply change is measured from the current issuance
+// until the rolling window has passed the reset
+func (k Keeper) ResetCircuitBreaker(ctx sdk.Context) {
+	k.SetSwapHalted(ctx, false)
+
+	issuance := k.SupplyKeeper.GetSupply(ctx).GetTotal()
+	k.SetCircuitBreakerReset(ctx, types.NewIssuanceBucket(types.GetIssuanceHour(ctx), issuance))
+}
+
+// getCircuitBreakerBaseIssuance returns the Luna issuance the circuit breaker measures the supply change from;
+// the issuance at the last reset if it is within the rolling window, or the base issuance of the window
+func (k Keeper) getCircuitBreakerBaseIssuance(ctx sdk.Context) sdk.Int {
+	windowStart := types.GetIssuanceHour(ctx) - types.IssuanceWindowHours
+	if reset, found := k.GetCircuitBreakerReset(ctx); found && reset.Hour >= windowStart {
+		return reset.Issuance.AmountOf(core.MicroLunaDenom)
+	}
+
+	return k.GetWindowBaseIssuance(ctx).AmountOf(core.MicroLunaDenom)
+}
+
+// CheckCircuitBreaker returns an error if a swap of offerCoin to swapCoin involving Luna is not allowed;
+// Luna swaps are refused while halted by governance, once the Luna supply change over the rolling window,
+// or since the last governance reset, has hit LunaDeltaHardLimit, or if the swap would push it beyond the limit
+func (k Keeper) CheckCircuitBreaker(ctx sdk.Context, offerCoin sdk.Coin, swapCoin sdk.Coin) sdk.Error {
+	lunaChange := sdk.ZeroInt()
+	if offerCoin.Denom == core.MicroLunaDenom {
+		lunaChange = offerCoin.Amount.Neg()
+	} else if swapCoin.Denom == core.MicroLunaDenom {
+		lunaChange = swapCoin.Amount
+	} else {
+		// Terra<>Terra swaps don't change the Luna supply
+		return nil
+	}
+
+	if k.GetSwapHalted(ctx) {
+		return types.ErrCircuitBreakerHalted(k.codespace)
+	}
+
+	limit := k.LunaDeltaHardLimit(ctx)
+	baseLunaIssuance := k.getCircuitBreakerBaseIssuance(ctx)
+	if lunaDelta := k.computeLunaDeltaFrom(ctx, baseLunaIssuance, sdk.ZeroInt()).Abs(); lunaDelta.GTE(limit) {
+		return types.ErrCircuitBreakerTripped(k.codespace, lunaDelta, limit)
+	}
+
+	if lunaDelta := k.computeLunaDeltaFrom(ctx, baseLunaIssuance, lunaChange).Abs(); lunaDelta.GT(limit) {
+		return types.ErrCircuitBreakerTripped(k.codespace, lunaDelta, limit)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/market/internal/types"
+)
+
+func TestSwapHalted(t *testing.T) {
+	input := CreateTestInput(t)
+	require.False(t, input.MarketKeeper.GetSwapHalted(input.Ctx))
+
+	input.MarketKeeper.SetSwapHalted(input.Ctx, true)
+	require.True(t, input.MarketKeeper.GetSwapHalted(input.Ctx))
+
+	input.MarketKeeper.SetSwapHalted(input.Ctx, false)
+	require.False(t, input.MarketKeeper.GetSwapHalted(input.Ctx))
+}
+
+func TestCheckCircuitBreaker(t *testing.T) {
+	input := CreateTestInput(t)
+	input.MarketKeeper.RecordHourlyIssuance(input.Ctx)
+	ctx := input.Ctx.WithBlockHeight(core.BlocksPerHour)
+
+	limit := input.MarketKeeper.LunaDeltaHardLimit(ctx)
+	issuance := input.SupplyKeeper.GetSupply(ctx).GetTotal().AmountOf(core.MicroLunaDenom)
+	sdrCoin := sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(1000))
+
+	// swaps within the limit go through in both directions
+	lunaCoin := sdk.NewCoin(core.MicroLunaDenom, limit.QuoInt64(2).MulInt(issuance).TruncateInt())
+	require.NoError(t, input.MarketKeeper.CheckCircuitBreaker(ctx, lunaCoin, sdrCoin))
+	require.NoError(t, input.MarketKeeper.CheckCircuitBreaker(ctx, sdrCoin, lunaCoin))
+
+	// swaps pushing the Luna supply change beyond the limit are refused
+	lunaCoin = sdk.NewCoin(core.MicroLunaDenom, limit.MulInt64(2).MulInt(issuance).TruncateInt())
+	err := input.MarketKeeper.CheckCircuitBreaker(ctx, lunaCoin, sdrCoin)
+	require.Error(t, err)
+	require.Equal(t, types.CodeCircuitBreaker, err.Code())
+	require.Error(t, input.MarketKeeper.CheckCircuitBreaker(ctx, sdrCoin, lunaCoin))
+
+	// once the limit is hit, every Luna swap is refused
+	err = input.SupplyKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(lunaCoin))
+	require.NoError(t, err)
+	smallLunaCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt())
+	require.Error(t, input.MarketKeeper.CheckCircuitBreaker(ctx, smallLunaCoin, sdrCoin))
+	require.Error(t, input.MarketKeeper.CheckCircuitBreaker(ctx, sdrCoin, smallLunaCoin))
+
+	// Terra<>Terra swaps are not affected
+	krwCoin := sdk.NewCoin(core.MicroKRWDenom, sdk.NewInt(1000))
+	require.NoError(t, input.MarketKeeper.CheckCircuitBreaker(ctx, sdrCoin, krwCoin))
+
+	// the breaker resets when the window rolls over
+	input.MarketKeeper.RecordHourlyIssuance(ctx)
+	ctx = ctx.WithBlockHeight(core.BlocksPerHour * (types.IssuanceWindowHours + 1))
+	require.NoError(t, input.MarketKeeper.CheckCircuitBreaker(ctx, smallLunaCoin, sdrCoin))
+
+	// governance halts every Luna swap until resumed
+	input.MarketKeeper.SetSwapHalted(ctx, true)
+	err = input.MarketKeeper.CheckCircuitBreaker(ctx, smallLunaCoin, sdrCoin)
+	require.Error(t, err)
+	require.Equal(t, types.CodeCircuitBreaker, err.Code())
+	require.NoError(t, input.MarketKeeper.CheckCircuitBreaker(ctx, sdrCoin, krwCoin))
+
+	input.MarketKeeper.SetSwapHalted(ctx, false)
+	require.NoError(t, input.MarketKeeper.CheckCircuitBreaker(ctx, smallLunaCoin, sdrCoin))
+}
+
+func TestResetCircuitBreaker(t *testing.T) {
+	input := CreateTestInput(t)
+	input.MarketKeeper.RecordHourlyIssuance(input.Ctx)
+	ctx := input.Ctx.WithBlockHeight(core.BlocksPerHour)
+
+	_, found := input.MarketKeeper.GetCircuitBreakerReset(ctx)
+	require.False(t, found)
+
+	limit := input.MarketKeeper.LunaDeltaHardLimit(ctx)
+	issuance := input.SupplyKeeper.GetSupply(ctx).GetTotal().AmountOf(core.MicroLunaDenom)
+	sdrCoin := sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(1000))
+	smallLunaCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt())
+
+	// trip the breaker on the supply change
+	lunaCoin := sdk.NewCoin(core.MicroLunaDenom, limit.MulInt64(2).MulInt(issuance).TruncateInt())
+	require.NoError(t, input.SupplyKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(lunaCoin)))
+	require.Error(t, input.MarketKeeper.CheckCircuitBreaker(ctx, smallLunaCoin, sdrCoin))
+
+	// a reset measures the supply change from the current issuance
+	input.MarketKeeper.SetSwapHalted(ctx, true)
+	input.MarketKeeper.ResetCircuitBreaker(ctx)
+	require.False(t, input.MarketKeeper.GetSwapHalted(ctx))
+
+	reset, found := input.MarketKeeper.GetCircuitBreakerReset(ctx)
+	require.True(t, found)
+	require.Equal(t, types.GetIssuanceHour(ctx), reset.Hour)
+	require.Equal(t, issuance.Add(lunaCoin.Amount), reset.Issuance.AmountOf(core.MicroLunaDenom))
+	require.NoError(t, input.MarketKeeper.CheckCircuitBreaker(ctx, smallLunaCoin, sdrCoin))
+
+	// the spread still sees the supply change over the rolling window
+	require.True(t, input.MarketKeeper.ComputeLunaDelta(ctx, sdk.ZeroInt()).GT(limit))
+
+	// the limit applies from the reset
+	require.NoError(t, input.SupplyKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(lunaCoin)))
+	require.Error(t, input.MarketKeeper.CheckCircuitBreaker(ctx, smallLunaCoin, sdrCoin))
+
+	// the reset is no longer used once the rolling window has passed it
+	input.MarketKeeper.RecordHourlyIssuance(ctx)
+	ctx = ctx.WithBlockHeight(core.BlocksPerHour * (types.IssuanceWindowHours + 2))
+	require.NoError(t, input.MarketKeeper.CheckCircuitBreaker(ctx, smallLunaCoin, sdrCoin))
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/market/internal/types"
+)
+
+// DistributeSwapFee sends the swap fee held by the market module to the oracle reward pool
+// and the community pool, split by OracleFeeShare, and records it to the current epoch proceeds.
+func (k Keeper) DistributeSwapFee(ctx sdk.Context, fee sdk.Coin) sdk.Error {
+	if !fee.IsPositive() {
+		return nil
+	}
+
+	// Send the oracle share to oracle module
+	oracleFeeAmt := k.OracleFeeShare(ctx).MulInt(fee.Amount).TruncateInt()
+	oracleFeeCoins := sdk.NewCoins(sdk.NewCoin(fee.Denom, oracleFeeAmt))
+	if !oracleFeeCoins.Empty() {
+		err := k.SupplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.oracleModuleName, oracleFeeCoins)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Send left to distribution module
+	communityFeeCoins := sdk.NewCoins(sdk.NewCoin(fee.Denom, fee.Amount.Sub(oracleFeeAmt)))
+	if !communityFeeCoins.Empty() {
+		err := k.SupplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.distributionModuleName, communityFeeCoins)
+		if err != nil {
+			return err
+		}
+
+		// Update distribution community pool
+		feePool := k.distrKeeper.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoins(communityFeeCoins))
+		k.distrKeeper.SetFeePool(ctx, feePool)
+	}
+
+	k.RecordSwapFeeProceeds(ctx, sdk.NewCoins(fee))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventSwapFee,
+			sdk.NewAttribute(types.AttributeKeySwapFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyOracleFee, oracleFeeCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyCommunity, communityFeeCoins.String()),
+		),
+	)
+
+	return nil
+}
+
+// RecordSwapFeeProceeds adds swap fees that have been collected this epoch
+func (k Keeper) RecordSwapFeeProceeds(ctx sdk.Context, delta sdk.Coins) {
+	if delta.Empty() {
+		return
+	}
+
+	epoch := core.GetEpoch(ctx)
+	proceeds := k.PeekSwapFeeProceeds(ctx, epoch)
+	proceeds = proceeds.Add(delta)
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(proceeds)
+	store.Set(types.GetSwapFeeProceedsKey(epoch), bz)
+}
+
+// PeekSwapFeeProceeds peeks the total amount of swap fees that have been collected in the given epoch.
+func (k Keeper) PeekSwapFeeProceeds(ctx sdk.Context, epoch int64) (res sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSwapFeeProceedsKey(epoch))
+	if bz == nil {
+		res = sdk.Coins{}
+	} else {
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &res)
+	}
+	return
+}
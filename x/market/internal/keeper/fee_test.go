@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/market/internal/types"
+	"github.com/terra-project/core/x/oracle"
+)
+
+func TestDistributeSwapFee(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.OracleFeeShare = sdk.NewDecWithPrec(7, 1)
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	fee := sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(1000))
+	err := input.SupplyKeeper.MintCoins(input.Ctx, types.ModuleName, sdk.NewCoins(fee))
+	require.NoError(t, err)
+
+	err = input.MarketKeeper.DistributeSwapFee(input.Ctx, fee)
+	require.NoError(t, err)
+
+	oracleAcc := input.SupplyKeeper.GetModuleAccount(input.Ctx, oracle.ModuleName)
+	require.Equal(t, sdk.NewInt(700), oracleAcc.GetCoins().AmountOf(core.MicroSDRDenom))
+
+	distrAcc := input.SupplyKeeper.GetModuleAccount(input.Ctx, distribution.ModuleName)
+	require.Equal(t, sdk.NewInt(300), distrAcc.GetCoins().AmountOf(core.MicroSDRDenom))
+
+	feePool := input.DistrKeeper.GetFeePool(input.Ctx)
+	require.Equal(t, sdk.NewDec(300), feePool.CommunityPool.AmountOf(core.MicroSDRDenom))
+
+	marketAcc := input.SupplyKeeper.GetModuleAccount(input.Ctx, types.ModuleName)
+	require.True(t, marketAcc.GetCoins().Empty())
+
+	proceeds := input.MarketKeeper.PeekSwapFeeProceeds(input.Ctx, core.GetEpoch(input.Ctx))
+	require.Equal(t, sdk.NewCoins(fee), proceeds)
+
+	// zero fee is neither distributed nor recorded
+	err = input.MarketKeeper.DistributeSwapFee(input.Ctx, sdk.NewCoin(core.MicroSDRDenom, sdk.ZeroInt()))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(fee), input.MarketKeeper.PeekSwapFeeProceeds(input.Ctx, core.GetEpoch(input.Ctx)))
+}
+
+func TestSwapFeeProceedsPerEpoch(t *testing.T) {
+	input := CreateTestInput(t)
+
+	fee := sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(100)))
+	input.MarketKeeper.RecordSwapFeeProceeds(input.Ctx, fee)
+	input.MarketKeeper.RecordSwapFeeProceeds(input.Ctx, fee)
+	require.Equal(t, fee.Add(fee), input.MarketKeeper.PeekSwapFeeProceeds(input.Ctx, 0))
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch)
+	input.MarketKeeper.RecordSwapFeeProceeds(input.Ctx, fee)
+	require.Equal(t, fee.Add(fee), input.MarketKeeper.PeekSwapFeeProceeds(input.Ctx, 0))
+	require.Equal(t, fee, input.MarketKeeper.PeekSwapFeeProceeds(input.Ctx, 1))
+}
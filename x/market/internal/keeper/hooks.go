@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/market/internal/types"
+)
+
+// Implements MarketHooks
+var _ types.MarketHooks = Keeper{}
+
+// BeforeSwap - call hook if registered
+func (k Keeper) BeforeSwap(ctx sdk.Context, trader sdk.AccAddress, offerCoin sdk.Coin, askDenom string) sdk.Error {
+	if k.hooks != nil {
+		return k.hooks.BeforeSwap(ctx, trader, offerCoin, askDenom)
+	}
+
+	return nil
+}
+
+// AfterSwap - call hook if registered
+func (k Keeper) AfterSwap(ctx sdk.Context, trader sdk.AccAddress, offerCoin sdk.Coin, swapCoin sdk.Coin, swapFee sdk.Coin) {
+	if k.hooks != nil {
+		k.hooks.AfterSwap(ctx, trader, offerCoin, swapCoin, swapFee)
+	}
+}
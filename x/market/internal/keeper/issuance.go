@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/market/internal/types"
+)
+
+// SetIssuanceBucket stores the issuance bucket of an hour
+func (k Keeper) SetIssuanceBucket(ctx sdk.Context, bucket types.IssuanceBucket) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(bucket)
+	store.Set(types.GetIssuanceBucketKey(bucket.Hour), bz)
+}
+
+// DeleteIssuanceBucket deletes the issuance bucket of an hour
+func (k Keeper) DeleteIssuanceBucket(ctx sdk.Context, hour int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetIssuanceBucketKey(hour))
+}
+
+// IterateIssuanceBuckets iterates over issuance buckets from the oldest hour
+func (k Keeper) IterateIssuanceBuckets(ctx sdk.Context, handler func(bucket types.IssuanceBucket) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.IssuanceBucketKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var bucket types.IssuanceBucket
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &bucket)
+		if handler(bucket) {
+			break
+		}
+	}
+}
+
+// GetIssuanceBuckets returns all issuance buckets from the oldest hour
+func (k Keeper) GetIssuanceBuckets(ctx sdk.Context) (buckets types.IssuanceBuckets) {
+	buckets = types.IssuanceBuckets{}
+	k.IterateIssuanceBuckets(ctx, func(bucket types.IssuanceBucket) (stop bool) {
+		buckets = append(buckets, bucket)
+		return false
+	})
+	return
+}
+
+// RecordHourlyIssuance stores the current total issuance as the bucket of the current hour,
+// and prunes the buckets that fall out of the rolling window
+func (k Keeper) RecordHourlyIssuance(ctx sdk.Context) sdk.Coins {
+	hour := types.GetIssuanceHour(ctx)
+	totalCoins := k.SupplyKeeper.GetSupply(ctx).GetTotal()
+	k.SetIssuanceBucket(ctx, types.NewIssuanceBucket(hour, totalCoins))
+
+	var staleHours []int64
+	k.IterateIssuanceBuckets(ctx, func(bucket types.IssuanceBucket) (stop bool) {
+		if bucket.Hour > hour-types.IssuanceWindowHours {
+			return true
+		}
+
+		staleHours = append(staleHours, bucket.Hour)
+		return false
+	})
+
+	for _, staleHour := range staleHours {
+		k.DeleteIssuanceBucket(ctx, staleHour)
+	}
+
+	return totalCoins
+}
+
+// GetWindowBaseIssuance returns the issuance at the start of the rolling window, which is the oldest
+// bucket within IssuanceWindowHours of the current hour; falls back to the legacy prev day issuance
+// if no bucket has been recorded yet
+func (k Keeper) GetWindowBaseIssuance(ctx sdk.Context) (issuance sdk.Coins) {
+	windowStart := types.GetIssuanceHour(ctx) - types.IssuanceWindowHours
+
+	found := false
+	k.IterateIssuanceBuckets(ctx, func(bucket types.IssuanceBucket) (stop bool) {
+		if bucket.Hour < windowStart {
+			return false
+		}
+
+		issuance = bucket.Issuance
+		found = true
+		return true
+	})
+
+	if found {
+		return
+	}
+
+	return k.getLegacyPrevDayIssuance(ctx)
+}
+
+// MigratePrevDayIssuance moves the legacy prev day issuance snapshot into the issuance bucket
+// of the last hour of the previous day, and removes the legacy key. Run when the market genesis
+// is exported, so the snapshot of a store upgraded through a genesis export carries over.
+func (k Keeper) MigratePrevDayIssuance(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.PrevDayIssuanceKey) {
+		return
+	}
+
+	issuance := k.getLegacyPrevDayIssuance(ctx)
+	store.Delete(types.PrevDayIssuanceKey)
+
+	// the legacy snapshot was taken at the last block of the previous day
+	hour := (ctx.BlockHeight()/core.BlocksPerDay)*types.IssuanceWindowHours - 1
+	if hour < 0 || issuance.Empty() {
+		return
+	}
+
+	k.SetIssuanceBucket(ctx, types.NewIssuanceBucket(hour, issuance))
+}
+
+func (k Keeper) getLegacyPrevDayIssuance(ctx sdk.Context) (issuance sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PrevDayIssuanceKey)
+	if bz == nil {
+		return sdk.Coins{}
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &issuance)
+	return
+}
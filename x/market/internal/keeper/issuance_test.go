@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/market/internal/types"
+)
+
+func setLunaIssuance(input TestInput, amount int64) {
+	supply := input.SupplyKeeper.GetSupply(input.Ctx)
+	supply = supply.SetTotal(sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(amount))))
+	input.SupplyKeeper.SetSupply(input.Ctx, supply)
+}
+
+func TestRecordHourlyIssuance(t *testing.T) {
+	input := CreateTestInput(t)
+
+	issuance := input.MarketKeeper.GetWindowBaseIssuance(input.Ctx).AmountOf(core.MicroLunaDenom)
+	require.True(t, issuance.IsZero())
+
+	// record a bucket at the last block of every hour, for two days
+	for hour := int64(0); hour < 2*types.IssuanceWindowHours; hour++ {
+		input.Ctx = input.Ctx.WithBlockHeight((hour+1)*core.BlocksPerHour - 1)
+		setLunaIssuance(input, 1000+hour)
+		input.MarketKeeper.RecordHourlyIssuance(input.Ctx)
+	}
+
+	// only the buckets of the rolling window are kept
+	buckets := input.MarketKeeper.GetIssuanceBuckets(input.Ctx)
+	require.Equal(t, int(types.IssuanceWindowHours), len(buckets))
+	require.Equal(t, types.IssuanceWindowHours, buckets[0].Hour)
+	require.Equal(t, 2*types.IssuanceWindowHours-1, buckets[len(buckets)-1].Hour)
+
+	// the window base slides every hour, not at the day boundary
+	input.Ctx = input.Ctx.WithBlockHeight(2 * core.BlocksPerDay)
+	issuance = input.MarketKeeper.GetWindowBaseIssuance(input.Ctx).AmountOf(core.MicroLunaDenom)
+	require.Equal(t, sdk.NewInt(1000+types.IssuanceWindowHours), issuance)
+
+	input.Ctx = input.Ctx.WithBlockHeight(2*core.BlocksPerDay + core.BlocksPerHour)
+	issuance = input.MarketKeeper.GetWindowBaseIssuance(input.Ctx).AmountOf(core.MicroLunaDenom)
+	require.Equal(t, sdk.NewInt(1000+types.IssuanceWindowHours+1), issuance)
+}
+
+func TestWindowBaseIssuanceSkipsStaleBuckets(t *testing.T) {
+	input := CreateTestInput(t)
+
+	input.MarketKeeper.SetIssuanceBucket(input.Ctx, types.NewIssuanceBucket(0, sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(1)))))
+	input.MarketKeeper.SetIssuanceBucket(input.Ctx, types.NewIssuanceBucket(30, sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(2)))))
+
+	input.Ctx = input.Ctx.WithBlockHeight(40 * core.BlocksPerHour)
+	issuance := input.MarketKeeper.GetWindowBaseIssuance(input.Ctx).AmountOf(core.MicroLunaDenom)
+	require.Equal(t, sdk.NewInt(2), issuance)
+
+	// no bucket within the window
+	input.Ctx = input.Ctx.WithBlockHeight(60 * core.BlocksPerHour)
+	issuance = input.MarketKeeper.GetWindowBaseIssuance(input.Ctx).AmountOf(core.MicroLunaDenom)
+	require.True(t, issuance.IsZero())
+}
+
+func TestMigratePrevDayIssuance(t *testing.T) {
+	input := CreateTestInput(t)
+
+	legacyIssuance := sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(1000)))
+	store := input.Ctx.KVStore(input.MarketKeeper.storeKey)
+	store.Set(types.PrevDayIssuanceKey, input.Cdc.MustMarshalBinaryLengthPrefixed(legacyIssuance))
+
+	// the legacy snapshot is used until it is migrated
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerDay + 10)
+	require.Equal(t, legacyIssuance, input.MarketKeeper.GetWindowBaseIssuance(input.Ctx))
+
+	input.MarketKeeper.MigratePrevDayIssuance(input.Ctx)
+	require.False(t, store.Has(types.PrevDayIssuanceKey))
+
+	buckets := input.MarketKeeper.GetIssuanceBuckets(input.Ctx)
+	require.Equal(t, types.IssuanceBuckets{types.NewIssuanceBucket(types.IssuanceWindowHours-1, legacyIssuance)}, buckets)
+	require.Equal(t, legacyIssuance, input.MarketKeeper.GetWindowBaseIssuance(input.Ctx))
+
+	// migration is a no-op once the legacy key is gone
+	input.MarketKeeper.MigratePrevDayIssuance(input.Ctx)
+	require.Equal(t, buckets, input.MarketKeeper.GetIssuanceBuckets(input.Ctx))
+}
@@ -21,6 +21,12 @@ type Keeper struct {
 
 	oracleKeeper types.OracleKeeper
 	SupplyKeeper types.SupplyKeeper
+	distrKeeper  types.DistributionKeeper
+
+	hooks types.MarketHooks
+
+	oracleModuleName       string
+	distributionModuleName string
 
 	// codespace
 	codespace sdk.CodespaceType
@@ -29,15 +35,29 @@ type Keeper struct {
 // NewKeeper constructs a new keeper for oracle
 func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey,
 	paramspace params.Subspace, oracleKeeper types.OracleKeeper,
-	supplyKeeper types.SupplyKeeper, codespace sdk.CodespaceType) Keeper {
+	supplyKeeper types.SupplyKeeper, distrKeeper types.DistributionKeeper,
+	oracleModuleName string, distributionModuleName string, codespace sdk.CodespaceType) Keeper {
 	return Keeper{
-		cdc:          cdc,
-		storeKey:     storeKey,
-		paramSpace:   paramspace.WithKeyTable(ParamKeyTable()),
-		oracleKeeper: oracleKeeper,
-		SupplyKeeper: supplyKeeper,
-		codespace:    codespace,
+		cdc:                    cdc,
+		storeKey:               storeKey,
+		paramSpace:             paramspace.WithKeyTable(ParamKeyTable()),
+		oracleKeeper:           oracleKeeper,
+		SupplyKeeper:           supplyKeeper,
+		distrKeeper:            distrKeeper,
+		oracleModuleName:       oracleModuleName,
+		distributionModuleName: distributionModuleName,
+		codespace:              codespace,
+	}
+}
+
+// SetHooks sets the market hooks
+func (k *Keeper) SetHooks(mh types.MarketHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set market hooks twice")
 	}
+
+	k.hooks = mh
+	return k
 }
 
 // Logger returns a module-specific logger.
@@ -50,38 +70,15 @@ func (k Keeper) Codespace() sdk.CodespaceType {
 	return k.codespace
 }
 
-// GetPrevDayIssuance returns the prev day issuance
-func (k Keeper) GetPrevDayIssuance(ctx sdk.Context) (issuance sdk.Coins) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.PrevDayIssuanceKey)
-	if bz == nil {
-		return sdk.Coins{}
-	}
-
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &issuance)
-	return
-}
-
-// UpdatePrevDayIssuance stores the prev day issuance
-func (k Keeper) UpdatePrevDayIssuance(ctx sdk.Context) sdk.Coins {
-	store := ctx.KVStore(k.storeKey)
-
-	totalCoins := k.SupplyKeeper.GetSupply(ctx).GetTotal()
-	bz := k.cdc.MustMarshalBinaryLengthPrefixed(totalCoins)
-	store.Set(types.PrevDayIssuanceKey, bz)
-
-	return totalCoins
-}
-
-// ComputeLunaDelta returns the issuance change rate of Luna for the day post-swap
+// ComputeLunaDelta returns the issuance change rate of Luna over the rolling 24-hour window post-swap
 func (k Keeper) ComputeLunaDelta(ctx sdk.Context, change sdk.Int) sdk.Dec {
-	curDay := ctx.BlockHeight() / core.BlocksPerDay
-	if curDay == 0 {
-		return sdk.ZeroDec()
-	}
+	baseLunaIssuance := k.GetWindowBaseIssuance(ctx).AmountOf(core.MicroLunaDenom)
+	return k.computeLunaDeltaFrom(ctx, baseLunaIssuance, change)
+}
 
-	prevDayLunaIssuance := k.GetPrevDayIssuance(ctx).AmountOf(core.MicroLunaDenom)
-	if prevDayLunaIssuance.IsZero() {
+// computeLunaDeltaFrom returns the Luna supply change rate from baseLunaIssuance, if the supply changes by change
+func (k Keeper) computeLunaDeltaFrom(ctx sdk.Context, baseLunaIssuance sdk.Int, change sdk.Int) sdk.Dec {
+	if baseLunaIssuance.IsZero() {
 		return sdk.ZeroDec()
 	}
 
@@ -90,33 +87,66 @@ func (k Keeper) ComputeLunaDelta(ctx sdk.Context, change sdk.Int) sdk.Dec {
 
 	postSwapIssunace := lunaIssuance.Add(change)
 
-	return sdk.NewDecFromInt(postSwapIssunace.Sub(prevDayLunaIssuance)).QuoInt(prevDayLunaIssuance)
+	return sdk.NewDecFromInt(postSwapIssunace.Sub(baseLunaIssuance)).QuoInt(baseLunaIssuance)
 }
 
-// ComputeLunaSwapSpread returns a spread, which is initialiy MinSwapSpread and grows linearly to MaxSwapSpread with delta
-func (k Keeper) ComputeLunaSwapSpread(ctx sdk.Context, postLunaDelta sdk.Dec) sdk.Dec {
-	if postLunaDelta.GTE(k.DailyLunaDeltaCap(ctx)) {
-		return k.MaxSwapSpread(ctx)
+// ComputeLunaSwapSpread returns a spread, which is initialiy MinSwapSpread and grows linearly to MaxSwapSpread with delta;
+// the params of the Terra side of the swap, terraDenom, are applied
+func (k Keeper) ComputeLunaSwapSpread(ctx sdk.Context, terraDenom string, postLunaDelta sdk.Dec) sdk.Dec {
+	denomParams := k.GetDenomSwapParams(ctx, terraDenom)
+	if postLunaDelta.GTE(denomParams.DailyLunaDeltaCap) {
+		return denomParams.MaxSwapSpread
 	}
 
 	// min + (p / l) (max - min); l = dailyDeltaCap, p = postDailyDelta,
-	return k.MinSwapSpread(ctx).Add(postLunaDelta.Quo(k.DailyLunaDeltaCap(ctx)).Mul(k.MaxSwapSpread(ctx).Sub(k.MinSwapSpread(ctx))))
+	return denomParams.MinSwapSpread.Add(postLunaDelta.Quo(denomParams.DailyLunaDeltaCap).Mul(denomParams.MaxSwapSpread.Sub(denomParams.MinSwapSpread)))
+}
+
+// GetSwapRate returns the oracle price of Luna in the denom to swap at. Returns an Error if the oracle has no price
+// for the denom, or if its price was tallied more than MaxOraclePriceAge vote periods ago.
+func (k Keeper) GetSwapRate(ctx sdk.Context, denom string) (sdk.Dec, sdk.Error) {
+	if _, err := k.oracleKeeper.GetLunaPrice(ctx, denom); err != nil {
+		return sdk.ZeroDec(), types.ErrNoEffectivePrice(k.codespace, denom)
+	}
+
+	rate, err := k.oracleKeeper.GetFreshLunaPrice(ctx, denom, k.MaxOraclePriceAge(ctx))
+	if err != nil {
+		return sdk.ZeroDec(), types.ErrStalePrice(k.codespace, denom)
+	}
+
+	return rate, nil
+}
+
+// ValidateScheduleDenom returns an Error if the denom is neither Luna nor a whitelisted oracle denom with a price,
+// as a swap schedule of such a denom could never be executed
+func (k Keeper) ValidateScheduleDenom(ctx sdk.Context, denom string) sdk.Error {
+	if denom == core.MicroLunaDenom {
+		return nil
+	}
+
+	if !k.oracleKeeper.IsWhitelisted(ctx, denom) {
+		return types.ErrNoEffectivePrice(k.codespace, denom)
+	}
+
+	_, err := k.GetSwapRate(ctx, denom)
+	return err
 }
 
 // GetSwapCoin returns the amount of asked coins should be returned for a given offerCoin at the effective
-// exchange rate registered with the oracle.
+// exchange rate registered with the oracle, and the spread to be charged on it; swaps involving Luna are charged
+// under the SpreadModel param, and Terra<>Terra swaps are charged the Tobin tax.
 // Returns an Error if the swap is recursive, or the coins to be traded are unknown by the oracle, or the amount
 // to trade is too small.
 // Ignores caps and spreads if isInternal = true.
 func (k Keeper) GetSwapCoin(ctx sdk.Context, offerCoin sdk.Coin, askDenom string, isInternal bool) (retCoin sdk.Coin, spread sdk.Dec, err sdk.Error) {
-	offerRate, err := k.oracleKeeper.GetLunaPrice(ctx, offerCoin.Denom)
+	offerRate, err := k.GetSwapRate(ctx, offerCoin.Denom)
 	if err != nil {
-		return sdk.Coin{}, sdk.ZeroDec(), types.ErrNoEffectivePrice(types.DefaultCodespace, offerCoin.Denom)
+		return sdk.Coin{}, sdk.ZeroDec(), err
 	}
 
-	askRate, err := k.oracleKeeper.GetLunaPrice(ctx, askDenom)
+	askRate, err := k.GetSwapRate(ctx, askDenom)
 	if err != nil {
-		return sdk.Coin{}, sdk.ZeroDec(), types.ErrNoEffectivePrice(types.DefaultCodespace, askDenom)
+		return sdk.Coin{}, sdk.ZeroDec(), err
 	}
 
 	retAmount := sdk.NewDecFromInt(offerCoin.Amount).Mul(askRate).Quo(offerRate).TruncateInt()
@@ -124,38 +154,78 @@ func (k Keeper) GetSwapCoin(ctx sdk.Context, offerCoin sdk.Coin, askDenom string
 		return sdk.Coin{}, sdk.ZeroDec(), types.ErrInsufficientSwapCoins(types.DefaultCodespace, offerCoin.Amount)
 	}
 
-	// We only charge spread for NON-INTERNAL swaps involving luna; if not, just pass.
-	if isInternal || (offerCoin.Denom != core.MicroLunaDenom && askDenom != core.MicroLunaDenom) {
+	// Internal swaps are not charged a spread
+	if isInternal {
 		return sdk.NewCoin(askDenom, retAmount), sdk.ZeroDec(), nil
 	}
 
-	dailyDelta := sdk.ZeroDec()
-	if offerCoin.Denom == core.MicroLunaDenom {
-		dailyDelta = k.ComputeLunaDelta(ctx, offerCoin.Amount.Neg())
-	} else if askDenom == core.MicroLunaDenom {
-		dailyDelta = k.ComputeLunaDelta(ctx, retAmount)
+	// Terra<>Terra swaps are charged the Tobin tax
+	if offerCoin.Denom != core.MicroLunaDenom && askDenom != core.MicroLunaDenom {
+		return sdk.NewCoin(askDenom, retAmount), k.GetTobinTax(ctx, offerCoin.Denom, askDenom), nil
+	}
+
+	if k.SpreadModel(ctx) == types.SpreadModelLinear {
+		dailyDelta := sdk.ZeroDec()
+		terraDenom := offerCoin.Denom
+		if offerCoin.Denom == core.MicroLunaDenom {
+			dailyDelta = k.ComputeLunaDelta(ctx, offerCoin.Amount.Neg())
+			terraDenom = askDenom
+		} else if askDenom == core.MicroLunaDenom {
+			dailyDelta = k.ComputeLunaDelta(ctx, retAmount)
+		}
+
+		// delta should be positive to apply spread
+		dailyDelta = dailyDelta.Abs()
+		spread = k.ComputeLunaSwapSpread(ctx, terraDenom, dailyDelta)
+
+		return sdk.NewCoin(askDenom, retAmount), spread, nil
 	}
 
-	// delta should be positive to apply spread
-	dailyDelta = dailyDelta.Abs()
-	spread = k.ComputeLunaSwapSpread(ctx, dailyDelta)
+	spread, err = k.ComputeLunaPoolSpread(ctx, offerCoin, askDenom)
+	if err != nil {
+		return sdk.Coin{}, sdk.ZeroDec(), err
+	}
 
 	return sdk.NewCoin(askDenom, retAmount), spread, nil
 }
 
+// GetTobinTax returns the Tobin tax rate of a Terra<>Terra swap, which is the higher rate of the two denoms;
+// a denom without an override is charged the default TobinTax param
+func (k Keeper) GetTobinTax(ctx sdk.Context, offerDenom string, askDenom string) sdk.Dec {
+	defaultRate := k.TobinTax(ctx)
+	overrides := k.TobinTaxOverrides(ctx)
+
+	offerRate, found := overrides.RateOf(offerDenom)
+	if !found {
+		offerRate = defaultRate
+	}
+
+	askRate, found := overrides.RateOf(askDenom)
+	if !found {
+		askRate = defaultRate
+	}
+
+	if offerRate.GT(askRate) {
+		return offerRate
+	}
+
+	return askRate
+}
+
 // GetSwapDecCoin returns the amount of asked DecCoins should be returned for a given offerCoin at the effective
 // exchange rate registered with the oracle.
 // Different from swapcoins, SwapDecCoins does not charge a spread as its use is system internal.
 // Similar to SwapCoins, but operates over sdk.DecCoins for convenience and accuracy.
+// Like swaps, refuses an oracle price tallied more than MaxOraclePriceAge vote periods ago.
 func (k Keeper) GetSwapDecCoin(ctx sdk.Context, offerCoin sdk.DecCoin, askDenom string) (sdk.DecCoin, sdk.Error) {
-	offerRate, err := k.oracleKeeper.GetLunaPrice(ctx, offerCoin.Denom)
+	offerRate, err := k.GetSwapRate(ctx, offerCoin.Denom)
 	if err != nil {
-		return sdk.DecCoin{}, types.ErrNoEffectivePrice(types.DefaultCodespace, offerCoin.Denom)
+		return sdk.DecCoin{}, err
 	}
 
-	askRate, err := k.oracleKeeper.GetLunaPrice(ctx, askDenom)
+	askRate, err := k.GetSwapRate(ctx, askDenom)
 	if err != nil {
-		return sdk.DecCoin{}, types.ErrNoEffectivePrice(types.DefaultCodespace, askDenom)
+		return sdk.DecCoin{}, err
 	}
 
 	retAmount := offerCoin.Amount.Mul(askRate).Quo(offerRate)
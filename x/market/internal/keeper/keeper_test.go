@@ -8,22 +8,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/market/internal/types"
 )
 
-func TestPrevDayLunaIssuanceUpdate(t *testing.T) {
-	input := CreateTestInput(t)
-
-	issuance := input.MarketKeeper.GetPrevDayIssuance(input.Ctx).AmountOf(core.MicroLunaDenom)
-	require.True(t, issuance.IsZero())
-
-	supply := input.SupplyKeeper.GetSupply(input.Ctx)
-	supply = supply.SetTotal(sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt())))
-	input.SupplyKeeper.SetSupply(input.Ctx, supply)
-	input.MarketKeeper.UpdatePrevDayIssuance(input.Ctx)
-	issuance = input.MarketKeeper.GetPrevDayIssuance(input.Ctx).AmountOf(core.MicroLunaDenom)
-	require.Equal(t, sdk.OneInt(), issuance)
-}
-
 func TestComputeLunaDelta(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -31,7 +18,7 @@ func TestComputeLunaDelta(t *testing.T) {
 		expectedDelta := sdk.NewDecWithPrec(rand.Int63n(1000), 3)
 		issuance := input.SupplyKeeper.GetSupply(input.Ctx).GetTotal().AmountOf(core.MicroLunaDenom)
 		change := expectedDelta.MulInt(issuance).TruncateInt()
-		input.MarketKeeper.UpdatePrevDayIssuance(input.Ctx)
+		input.MarketKeeper.RecordHourlyIssuance(input.Ctx)
 		delta := input.MarketKeeper.ComputeLunaDelta(input.Ctx.WithBlockHeight(core.BlocksPerDay), change)
 
 		require.Equal(t, expectedDelta, delta)
@@ -43,15 +30,15 @@ func TestComputeLunaSwapSpread(t *testing.T) {
 
 	for i := 0; i < 100; i++ {
 		delta := sdk.NewDecWithPrec(rand.Int63n(1000), 3)
-		spread := input.MarketKeeper.ComputeLunaSwapSpread(input.Ctx, delta)
+		spread := input.MarketKeeper.ComputeLunaSwapSpread(input.Ctx, core.MicroSDRDenom, delta)
 		require.True(t, spread.GTE(input.MarketKeeper.MinSwapSpread(input.Ctx)))
 		require.True(t, spread.LTE(input.MarketKeeper.MaxSwapSpread(input.Ctx)))
 	}
 
-	spread := input.MarketKeeper.ComputeLunaSwapSpread(input.Ctx, sdk.ZeroDec())
+	spread := input.MarketKeeper.ComputeLunaSwapSpread(input.Ctx, core.MicroSDRDenom, sdk.ZeroDec())
 	require.Equal(t, input.MarketKeeper.MinSwapSpread(input.Ctx), spread)
 
-	spread = input.MarketKeeper.ComputeLunaSwapSpread(input.Ctx, sdk.OneDec())
+	spread = input.MarketKeeper.ComputeLunaSwapSpread(input.Ctx, core.MicroSDRDenom, sdk.OneDec())
 	require.Equal(t, input.MarketKeeper.MaxSwapSpread(input.Ctx), spread)
 }
 
@@ -60,6 +47,10 @@ func TestGetSwapCoin(t *testing.T) {
 	lunaPriceInSDR := sdk.NewDecWithPrec(17, 1)
 	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, lunaPriceInSDR)
 
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.SpreadModel = types.SpreadModelLinear
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
 	// zero day (min spread)
 	for i := 0; i < 100; i++ {
 		offerCoin := sdk.NewCoin(core.MicroSDRDenom, lunaPriceInSDR.MulInt64(rand.Int63()+1).TruncateInt())
@@ -79,6 +70,50 @@ func TestGetSwapCoin(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestGetSwapCoinWithDenomParams(t *testing.T) {
+	input := CreateTestInput(t)
+	lunaPriceInSDR := sdk.NewDecWithPrec(17, 1)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, lunaPriceInSDR)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroKRWDenom, lunaPriceInSDR)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.SpreadModel = types.SpreadModelLinear
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	minSwapSpread := sdk.NewDecWithPrec(5, 2)
+	input.MarketKeeper.SetDenomParams(input.Ctx, types.NewDenomParams(core.MicroKRWDenom, sdk.NewDecWithPrec(1, 3), minSwapSpread, sdk.OneDec()))
+
+	// the override of the Terra side applies in both directions
+	offerCoin := sdk.NewCoin(core.MicroKRWDenom, sdk.NewInt(1000))
+	_, spread, err := input.MarketKeeper.GetSwapCoin(input.Ctx, offerCoin, core.MicroLunaDenom, false)
+	require.NoError(t, err)
+	require.Equal(t, minSwapSpread, spread)
+
+	offerCoin = sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(1000))
+	_, spread, err = input.MarketKeeper.GetSwapCoin(input.Ctx, offerCoin, core.MicroKRWDenom, false)
+	require.NoError(t, err)
+	require.Equal(t, minSwapSpread, spread)
+
+	// other denoms keep the global params
+	_, spread, err = input.MarketKeeper.GetSwapCoin(input.Ctx, offerCoin, core.MicroSDRDenom, false)
+	require.NoError(t, err)
+	require.Equal(t, input.MarketKeeper.MinSwapSpread(input.Ctx), spread)
+
+	// the constant-product model is bounded by the override as well
+	params = input.MarketKeeper.GetParams(input.Ctx)
+	params.SpreadModel = types.SpreadModelConstantProduct
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	_, spread, err = input.MarketKeeper.GetSwapCoin(input.Ctx, offerCoin, core.MicroKRWDenom, false)
+	require.NoError(t, err)
+	require.Equal(t, minSwapSpread, spread)
+
+	input.MarketKeeper.DeleteDenomParams(input.Ctx, core.MicroKRWDenom)
+	_, spread, err = input.MarketKeeper.GetSwapCoin(input.Ctx, offerCoin, core.MicroKRWDenom, false)
+	require.NoError(t, err)
+	require.Equal(t, input.MarketKeeper.MinSwapSpread(input.Ctx), spread)
+}
+
 func TestGetDecSwapCoin(t *testing.T) {
 	input := CreateTestInput(t)
 	lunaPriceInSDR := sdk.NewDecWithPrec(17, 1)
@@ -96,3 +131,39 @@ func TestGetDecSwapCoin(t *testing.T) {
 	_, err := input.MarketKeeper.GetSwapDecCoin(input.Ctx, offerCoin, core.MicroLunaDenom)
 	require.Error(t, err)
 }
+
+func TestGetTobinTax(t *testing.T) {
+	input := CreateTestInput(t)
+	lunaPriceInSDR := sdk.NewDecWithPrec(17, 1)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, lunaPriceInSDR)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroKRWDenom, sdk.NewDec(2000))
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroUSDDenom, sdk.NewDecWithPrec(15, 1))
+
+	defaultRate := input.MarketKeeper.TobinTax(input.Ctx)
+	require.Equal(t, defaultRate, input.MarketKeeper.GetTobinTax(input.Ctx, core.MicroKRWDenom, core.MicroSDRDenom))
+
+	// the higher rate of the two denoms applies, in either direction
+	krwRate := sdk.NewDecWithPrec(2, 2)
+	usdRate := sdk.NewDecWithPrec(1, 3)
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.TobinTaxOverrides = types.TobinTaxList{
+		types.NewTobinTax(core.MicroKRWDenom, krwRate),
+		types.NewTobinTax(core.MicroUSDDenom, usdRate),
+	}
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	require.Equal(t, krwRate, input.MarketKeeper.GetTobinTax(input.Ctx, core.MicroKRWDenom, core.MicroSDRDenom))
+	require.Equal(t, krwRate, input.MarketKeeper.GetTobinTax(input.Ctx, core.MicroSDRDenom, core.MicroKRWDenom))
+	require.Equal(t, defaultRate, input.MarketKeeper.GetTobinTax(input.Ctx, core.MicroUSDDenom, core.MicroSDRDenom))
+	require.Equal(t, krwRate, input.MarketKeeper.GetTobinTax(input.Ctx, core.MicroUSDDenom, core.MicroKRWDenom))
+
+	// Terra<>Terra swaps are charged the Tobin tax, unless internal
+	offerCoin := sdk.NewCoin(core.MicroKRWDenom, sdk.NewInt(core.MicroUnit))
+	_, spread, err := input.MarketKeeper.GetSwapCoin(input.Ctx, offerCoin, core.MicroUSDDenom, false)
+	require.NoError(t, err)
+	require.Equal(t, krwRate, spread)
+
+	_, spread, err = input.MarketKeeper.GetSwapCoin(input.Ctx, offerCoin, core.MicroUSDDenom, true)
+	require.NoError(t, err)
+	require.Equal(t, sdk.ZeroDec(), spread)
+}
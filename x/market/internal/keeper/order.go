@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/market/internal/types"
+)
+
+// GetNextLimitOrderID returns the ID to be assigned to the next limit order
+func (k Keeper) GetNextLimitOrderID(ctx sdk.Context) (orderID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextLimitOrderIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &orderID)
+	return
+}
+
+// SetNextLimitOrderID stores the ID to be assigned to the next limit order
+func (k Keeper) SetNextLimitOrderID(ctx sdk.Context, orderID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(orderID)
+	store.Set(types.NextLimitOrderIDKey, bz)
+}
+
+// GetLimitOrder retrieves a limit order from the store
+func (k Keeper) GetLimitOrder(ctx sdk.Context, orderID uint64) (order types.LimitOrder, err sdk.Error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLimitOrderKey(orderID))
+	if bz == nil {
+		err = types.ErrNoLimitOrder(k.codespace, orderID)
+		return
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &order)
+	return
+}
+
+// SetLimitOrder stores a limit order, and indexes it by its expiry height
+func (k Keeper) SetLimitOrder(ctx sdk.Context, order types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(order)
+	store.Set(types.GetLimitOrderKey(order.OrderID), bz)
+	store.Set(types.GetLimitOrderExpiryKey(order.ExpiryHeight, order.OrderID), []byte{})
+}
+
+// DeleteLimitOrder deletes a limit order and its expiry index from the store
+func (k Keeper) DeleteLimitOrder(ctx sdk.Context, orderID uint64) {
+	order, err := k.GetLimitOrder(ctx, orderID)
+	if err != nil {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetLimitOrderKey(orderID))
+	store.Delete(types.GetLimitOrderExpiryKey(order.ExpiryHeight, orderID))
+}
+
+// AddLimitOrder assigns the next ID to a new limit order and stores it
+func (k Keeper) AddLimitOrder(ctx sdk.Context, order types.LimitOrder) types.LimitOrder {
+	order.OrderID = k.GetNextLimitOrderID(ctx)
+	k.SetNextLimitOrderID(ctx, order.OrderID+1)
+	k.SetLimitOrder(ctx, order)
+
+	return order
+}
+
+// IterateLimitOrders iterates over limit orders in placement order
+func (k Keeper) IterateLimitOrders(ctx sdk.Context, handler func(order types.LimitOrder) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.LimitOrderKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var order types.LimitOrder
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &order)
+		if handler(order) {
+			break
+		}
+	}
+}
+
+// IterateLimitOrdersFrom iterates over limit orders in placement order, starting from the given order ID
+func (k Keeper) IterateLimitOrdersFrom(ctx sdk.Context, orderID uint64, handler func(order types.LimitOrder) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.GetLimitOrderKey(orderID), sdk.PrefixEndBytes(types.LimitOrderKey))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var order types.LimitOrder
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &order)
+		if handler(order) {
+			break
+		}
+	}
+}
+
+// IterateExpiredLimitOrders iterates over limit orders expiring at or before the given block height,
+// from the earliest expiry
+func (k Keeper) IterateExpiredLimitOrders(ctx sdk.Context, blockHeight int64, handler func(order types.LimitOrder) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.LimitOrderExpiryKey, types.GetLimitOrderExpiryPrefixKey(blockHeight+1))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		orderID := binary.BigEndian.Uint64(iter.Key()[len(types.GetLimitOrderExpiryPrefixKey(0)):])
+		order, err := k.GetLimitOrder(ctx, orderID)
+		if err != nil {
+			continue
+		}
+
+		if handler(order) {
+			break
+		}
+	}
+}
+
+// GetLimitOrderCursor returns the ID of the limit order to be tried first by the next matching
+func (k Keeper) GetLimitOrderCursor(ctx sdk.Context) (orderID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LimitOrderCursorKey)
+	if bz == nil {
+		return 0
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &orderID)
+	return
+}
+
+// SetLimitOrderCursor stores the ID of the limit order to be tried first by the next matching
+func (k Keeper) SetLimitOrderCursor(ctx sdk.Context, orderID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(orderID)
+	store.Set(types.LimitOrderCursorKey, bz)
+}
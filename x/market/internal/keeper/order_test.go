@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/market/internal/types"
+)
+
+func TestLimitOrderUpdate(t *testing.T) {
+	input := CreateTestInput(t)
+
+	require.Equal(t, uint64(1), input.MarketKeeper.GetNextLimitOrderID(input.Ctx))
+
+	_, err := input.MarketKeeper.GetLimitOrder(input.Ctx, 1)
+	require.Error(t, err)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	for i := 0; i < 3; i++ {
+		order := input.MarketKeeper.AddLimitOrder(input.Ctx,
+			types.NewLimitOrder(0, Addrs[i], offerCoin, core.MicroSDRDenom, sdk.OneDec(), 10))
+		require.Equal(t, uint64(i+1), order.OrderID)
+	}
+
+	require.Equal(t, uint64(4), input.MarketKeeper.GetNextLimitOrderID(input.Ctx))
+
+	order, err := input.MarketKeeper.GetLimitOrder(input.Ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, Addrs[1], order.Trader)
+
+	input.MarketKeeper.DeleteLimitOrder(input.Ctx, 2)
+	_, err = input.MarketKeeper.GetLimitOrder(input.Ctx, 2)
+	require.Error(t, err)
+
+	// orders iterate in placement order
+	var orderIDs []uint64
+	input.MarketKeeper.IterateLimitOrders(input.Ctx, func(order types.LimitOrder) (stop bool) {
+		orderIDs = append(orderIDs, order.OrderID)
+		return false
+	})
+	require.Equal(t, []uint64{1, 3}, orderIDs)
+
+	// deleted IDs are not reused
+	order = input.MarketKeeper.AddLimitOrder(input.Ctx,
+		types.NewLimitOrder(0, Addrs[0], offerCoin, core.MicroSDRDenom, sdk.OneDec(), 10))
+	require.Equal(t, uint64(4), order.OrderID)
+}
+
+func TestLimitOrderIndexes(t *testing.T) {
+	input := CreateTestInput(t)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	for _, expiryHeight := range []int64{30, 10, 20, 10} {
+		input.MarketKeeper.AddLimitOrder(input.Ctx,
+			types.NewLimitOrder(0, Addrs[0], offerCoin, core.MicroSDRDenom, sdk.OneDec(), expiryHeight))
+	}
+
+	expiredOrderIDs := func(blockHeight int64) (orderIDs []uint64) {
+		input.MarketKeeper.IterateExpiredLimitOrders(input.Ctx, blockHeight, func(order types.LimitOrder) (stop bool) {
+			orderIDs = append(orderIDs, order.OrderID)
+			return false
+		})
+		return
+	}
+
+	// expired orders iterate from the earliest expiry
+	require.Empty(t, expiredOrderIDs(9))
+	require.Equal(t, []uint64{2, 4}, expiredOrderIDs(10))
+	require.Equal(t, []uint64{2, 4, 3}, expiredOrderIDs(29))
+
+	// deleted orders are dropped from the expiry index
+	input.MarketKeeper.DeleteLimitOrder(input.Ctx, 4)
+	require.Equal(t, []uint64{2, 3, 1}, expiredOrderIDs(30))
+
+	var orderIDs []uint64
+	input.MarketKeeper.IterateLimitOrdersFrom(input.Ctx, 2, func(order types.LimitOrder) (stop bool) {
+		orderIDs = append(orderIDs, order.OrderID)
+		return false
+	})
+	require.Equal(t, []uint64{2, 3}, orderIDs)
+
+	require.Equal(t, uint64(0), input.MarketKeeper.GetLimitOrderCursor(input.Ctx))
+	input.MarketKeeper.SetLimitOrderCursor(input.Ctx, 3)
+	require.Equal(t, uint64(3), input.MarketKeeper.GetLimitOrderCursor(input.Ctx))
+}
@@ -29,6 +29,104 @@ func (k Keeper) MaxSwapSpread(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// DenomParams
+func (k Keeper) DenomParams(ctx sdk.Context) (res types.DenomParamsList) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyDenomParams, &res)
+	return
+}
+
+// SetDenomParams replaces the overrides of the denom, or appends them if the denom has none
+func (k Keeper) SetDenomParams(ctx sdk.Context, denomParams types.DenomParams) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyDenomParams, k.DenomParams(ctx).Upsert(denomParams))
+}
+
+// DeleteDenomParams removes the overrides of the denom
+func (k Keeper) DeleteDenomParams(ctx sdk.Context, denom string) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyDenomParams, k.DenomParams(ctx).Remove(denom))
+}
+
+// GetDenomSwapParams returns the Luna swap params applied to the Terra denom,
+// which are its overrides if any, or the global params
+func (k Keeper) GetDenomSwapParams(ctx sdk.Context, denom string) types.DenomParams {
+	if denomParams, found := k.DenomParams(ctx).ParamsOf(denom); found {
+		return denomParams
+	}
+
+	return types.NewDenomParams(denom, k.DailyLunaDeltaCap(ctx), k.MinSwapSpread(ctx), k.MaxSwapSpread(ctx))
+}
+
+// BasePool
+func (k Keeper) BasePool(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyBasePool, &res)
+	return
+}
+
+// PoolRecoveryPeriod
+func (k Keeper) PoolRecoveryPeriod(ctx sdk.Context) (res int64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyPoolRecoveryPeriod, &res)
+	return
+}
+
+// SpreadModel
+func (k Keeper) SpreadModel(ctx sdk.Context) (res string) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeySpreadModel, &res)
+	return
+}
+
+// TobinTax
+func (k Keeper) TobinTax(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyTobinTax, &res)
+	return
+}
+
+// TobinTaxOverrides
+func (k Keeper) TobinTaxOverrides(ctx sdk.Context) (res types.TobinTaxList) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyTobinTaxOverrides, &res)
+	return
+}
+
+// OracleFeeShare
+func (k Keeper) OracleFeeShare(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyOracleFeeShare, &res)
+	return
+}
+
+// LunaDeltaHardLimit
+func (k Keeper) LunaDeltaHardLimit(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyLunaDeltaHardLimit, &res)
+	return
+}
+
+// VolumeRetention
+func (k Keeper) VolumeRetention(ctx sdk.Context) (res int64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyVolumeRetention, &res)
+	return
+}
+
+// TraderVolume
+func (k Keeper) TraderVolume(ctx sdk.Context) (res bool) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyTraderVolume, &res)
+	return
+}
+
+// MaxOraclePriceAge
+func (k Keeper) MaxOraclePriceAge(ctx sdk.Context) (res int64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyMaxOraclePriceAge, &res)
+	return
+}
+
+// MaxLimitOrderMatches
+func (k Keeper) MaxLimitOrderMatches(ctx sdk.Context) (res int64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyMaxLimitOrderMatches, &res)
+	return
+}
+
+// MaxScheduleExecutions
+func (k Keeper) MaxScheduleExecutions(ctx sdk.Context) (res int64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyMaxScheduleExecutions, &res)
+	return
+}
+
 // GetParams returns the total set of market parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
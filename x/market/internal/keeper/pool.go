@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/market/internal/types"
+)
+
+// GetTerraPoolDelta returns the gap between the terra pool and the base pool, denominated in usdr
+func (k Keeper) GetTerraPoolDelta(ctx sdk.Context) (delta sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TerraPoolDeltaKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &delta)
+	return
+}
+
+// SetTerraPoolDelta stores the gap between the terra pool and the base pool, denominated in usdr
+func (k Keeper) SetTerraPoolDelta(ctx sdk.Context, delta sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(delta)
+	store.Set(types.TerraPoolDeltaKey, bz)
+}
+
+// ReplenishPools moves the terra pool (and so the luna pool) back toward the base pool
+// by 1/PoolRecoveryPeriod of the remaining delta
+func (k Keeper) ReplenishPools(ctx sdk.Context) {
+	delta := k.GetTerraPoolDelta(ctx)
+	if delta.IsZero() {
+		return
+	}
+
+	regressionAmt := delta.QuoInt64(k.PoolRecoveryPeriod(ctx))
+
+	// rounding can leave a dust delta that never regresses; settle it at once
+	if regressionAmt.IsZero() {
+		regressionAmt = delta
+	}
+
+	k.SetTerraPoolDelta(ctx, delta.Sub(regressionAmt))
+}
+
+// ComputeLunaPoolSpread returns the spread of a swap involving Luna against the virtual constant-product pools.
+// The spread is the slippage of the pool quote from the oracle quote, bounded by MinSwapSpread and MaxSwapSpread
+// of the Terra side of the swap.
+func (k Keeper) ComputeLunaPoolSpread(ctx sdk.Context, offerCoin sdk.Coin, askDenom string) (sdk.Dec, sdk.Error) {
+	baseOfferCoin, err := k.GetSwapDecCoin(ctx, sdk.NewDecCoinFromCoin(offerCoin), core.MicroSDRDenom)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	terraDenom := offerCoin.Denom
+	if offerCoin.Denom == core.MicroLunaDenom {
+		terraDenom = askDenom
+	}
+
+	denomParams := k.GetDenomSwapParams(ctx, terraDenom)
+	minSpread := denomParams.MinSwapSpread
+	maxSpread := denomParams.MaxSwapSpread
+
+	// constant-product, which by construction is the square of the base(equilibrium) pool
+	basePool := k.BasePool(ctx)
+	cp := basePool.Mul(basePool)
+
+	terraPool := basePool.Add(k.GetTerraPoolDelta(ctx))
+	if !terraPool.IsPositive() {
+		return maxSpread, nil
+	}
+
+	lunaPool := cp.Quo(terraPool)
+
+	offerPool, askPool := terraPool, lunaPool
+	if offerCoin.Denom == core.MicroLunaDenom {
+		offerPool, askPool = lunaPool, terraPool
+	}
+
+	// askBaseAmt = askPool - cp / (offerPool + offerBaseAmt)
+	// spread = (offerBaseAmt - askBaseAmt) / offerBaseAmt
+	offerBaseAmt := baseOfferCoin.Amount
+	askBaseAmt := askPool.Sub(cp.Quo(offerPool.Add(offerBaseAmt)))
+	spread := offerBaseAmt.Sub(askBaseAmt).Quo(offerBaseAmt)
+
+	if spread.LT(minSpread) {
+		return minSpread, nil
+	}
+
+	if spread.GT(maxSpread) {
+		return maxSpread, nil
+	}
+
+	return spread, nil
+}
+
+// ApplySwapToPool updates the terra pool delta with a swap involving Luna;
+// the offer side of the pool is filled with the offer coin, and the ask side is drained by the swapped coin.
+func (k Keeper) ApplySwapToPool(ctx sdk.Context, offerCoin sdk.Coin, swapCoin sdk.Coin) sdk.Error {
+	// Terra<>Terra swaps do not move the pools
+	if offerCoin.Denom != core.MicroLunaDenom && swapCoin.Denom != core.MicroLunaDenom {
+		return nil
+	}
+
+	terraPoolDelta := k.GetTerraPoolDelta(ctx)
+
+	if offerCoin.Denom != core.MicroLunaDenom {
+		// Terra->Luna swap fills the terra pool
+		offerBaseCoin, err := k.GetSwapDecCoin(ctx, sdk.NewDecCoinFromCoin(offerCoin), core.MicroSDRDenom)
+		if err != nil {
+			return err
+		}
+
+		terraPoolDelta = terraPoolDelta.Add(offerBaseCoin.Amount)
+	} else {
+		// Luna->Terra swap drains the terra pool
+		askBaseCoin, err := k.GetSwapDecCoin(ctx, sdk.NewDecCoinFromCoin(swapCoin), core.MicroSDRDenom)
+		if err != nil {
+			return err
+		}
+
+		terraPoolDelta = terraPoolDelta.Sub(askBaseCoin.Amount)
+	}
+
+	k.SetTerraPoolDelta(ctx, terraPoolDelta)
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+)
+
+func TestTerraPoolDeltaUpdate(t *testing.T) {
+	input := CreateTestInput(t)
+
+	terraPoolDelta := input.MarketKeeper.GetTerraPoolDelta(input.Ctx)
+	require.Equal(t, sdk.ZeroDec(), terraPoolDelta)
+
+	diff := sdk.NewDec(10)
+	input.MarketKeeper.SetTerraPoolDelta(input.Ctx, diff)
+
+	terraPoolDelta = input.MarketKeeper.GetTerraPoolDelta(input.Ctx)
+	require.Equal(t, diff, terraPoolDelta)
+}
+
+func TestReplenishPools(t *testing.T) {
+	input := CreateTestInput(t)
+
+	basePool := input.MarketKeeper.BasePool(input.Ctx)
+	recoveryPeriod := input.MarketKeeper.PoolRecoveryPeriod(input.Ctx)
+
+	diff := basePool.QuoInt64(core.MicroUnit)
+	input.MarketKeeper.SetTerraPoolDelta(input.Ctx, diff)
+
+	input.MarketKeeper.ReplenishPools(input.Ctx)
+
+	terraPoolDelta := input.MarketKeeper.GetTerraPoolDelta(input.Ctx)
+	require.Equal(t, diff.Sub(diff.QuoInt64(recoveryPeriod)), terraPoolDelta)
+
+	// dust delta is settled at once
+	input.MarketKeeper.SetTerraPoolDelta(input.Ctx, sdk.SmallestDec())
+	input.MarketKeeper.ReplenishPools(input.Ctx)
+	require.True(t, input.MarketKeeper.GetTerraPoolDelta(input.Ctx).IsZero())
+}
+
+func TestComputeLunaPoolSpread(t *testing.T) {
+	input := CreateTestInput(t)
+	lunaPriceInSDR := sdk.NewDecWithPrec(17, 1)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, lunaPriceInSDR)
+
+	minSpread := input.MarketKeeper.MinSwapSpread(input.Ctx)
+	maxSpread := input.MarketKeeper.MaxSwapSpread(input.Ctx)
+	basePool := input.MarketKeeper.BasePool(input.Ctx)
+
+	// small trades against the equilibrium pools are charged the min spread
+	offerCoin := sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(core.MicroUnit))
+	spread, err := input.MarketKeeper.ComputeLunaPoolSpread(input.Ctx, offerCoin, core.MicroLunaDenom)
+	require.NoError(t, err)
+	require.Equal(t, minSpread, spread)
+
+	// spread grows with the trade size; offering a whole base pool halves the return
+	offerCoin = sdk.NewCoin(core.MicroSDRDenom, basePool.TruncateInt())
+	spread, err = input.MarketKeeper.ComputeLunaPoolSpread(input.Ctx, offerCoin, core.MicroLunaDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), spread)
+
+	largerOfferCoin := sdk.NewCoin(core.MicroSDRDenom, basePool.MulInt64(2).TruncateInt())
+	largerSpread, err := input.MarketKeeper.ComputeLunaPoolSpread(input.Ctx, largerOfferCoin, core.MicroLunaDenom)
+	require.NoError(t, err)
+	require.True(t, largerSpread.GT(spread))
+	require.True(t, largerSpread.LTE(maxSpread))
+
+	// Luna offer of the same value is charged the same spread at equilibrium
+	lunaOfferCoin := sdk.NewCoin(core.MicroLunaDenom, basePool.Quo(lunaPriceInSDR).TruncateInt())
+	lunaSpread, err := input.MarketKeeper.ComputeLunaPoolSpread(input.Ctx, lunaOfferCoin, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.True(t, lunaSpread.Sub(spread).Abs().LT(sdk.NewDecWithPrec(1, 10)))
+
+	// a filled terra pool makes Terra->Luna swaps more expensive, and Luna->Terra swaps cheaper
+	input.MarketKeeper.SetTerraPoolDelta(input.Ctx, basePool)
+	spreadAfterFill, err := input.MarketKeeper.ComputeLunaPoolSpread(input.Ctx, offerCoin, core.MicroLunaDenom)
+	require.NoError(t, err)
+	require.True(t, spreadAfterFill.GT(spread))
+
+	lunaSpreadAfterFill, err := input.MarketKeeper.ComputeLunaPoolSpread(input.Ctx, lunaOfferCoin, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.True(t, lunaSpreadAfterFill.LT(lunaSpread))
+
+	// an exhausted terra pool charges the max spread
+	input.MarketKeeper.SetTerraPoolDelta(input.Ctx, basePool.Neg())
+	spread, err = input.MarketKeeper.ComputeLunaPoolSpread(input.Ctx, offerCoin, core.MicroLunaDenom)
+	require.NoError(t, err)
+	require.Equal(t, maxSpread, spread)
+
+	// no oracle price for the base denom
+	input.OracleKeeper.DeletePrice(input.Ctx, core.MicroSDRDenom)
+	_, err = input.MarketKeeper.ComputeLunaPoolSpread(input.Ctx, lunaOfferCoin, core.MicroSDRDenom)
+	require.Error(t, err)
+}
+
+func TestApplySwapToPool(t *testing.T) {
+	input := CreateTestInput(t)
+	lunaPriceInSDR := sdk.NewDecWithPrec(17, 1)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, lunaPriceInSDR)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroKRWDenom, sdk.NewDec(2000))
+
+	// Terra->Luna swap fills the terra pool by the offer value in usdr
+	offerCoin := sdk.NewCoin(core.MicroKRWDenom, sdk.NewInt(2000))
+	swapCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(1))
+	err := input.MarketKeeper.ApplySwapToPool(input.Ctx, offerCoin, swapCoin)
+	require.NoError(t, err)
+	require.Equal(t, lunaPriceInSDR, input.MarketKeeper.GetTerraPoolDelta(input.Ctx))
+
+	// Luna->Terra swap drains the terra pool by the swapped value in usdr
+	offerCoin = sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(1))
+	swapCoin = sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(17))
+	err = input.MarketKeeper.ApplySwapToPool(input.Ctx, offerCoin, swapCoin)
+	require.NoError(t, err)
+	require.Equal(t, lunaPriceInSDR.Sub(sdk.NewDec(17)), input.MarketKeeper.GetTerraPoolDelta(input.Ctx))
+
+	// Terra<>Terra swaps leave the pools untouched
+	delta := input.MarketKeeper.GetTerraPoolDelta(input.Ctx)
+	offerCoin = sdk.NewCoin(core.MicroKRWDenom, sdk.NewInt(2000))
+	swapCoin = sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(1))
+	err = input.MarketKeeper.ApplySwapToPool(input.Ctx, offerCoin, swapCoin)
+	require.NoError(t, err)
+	require.Equal(t, delta, input.MarketKeeper.GetTerraPoolDelta(input.Ctx))
+}
@@ -1,21 +1,50 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	core "github.com/terra-project/core/types"
 	"github.com/terra-project/core/x/market/internal/types"
 )
 
+// default page size of paginated queries
+const defaultQueryLimit = 100
+
 // NewQuerier is the module level router for state queries
 func NewQuerier(keeper Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
 		switch path[0] {
 		case types.QuerySwap:
 			return querySwap(ctx, req, keeper)
+		case types.QueryRouteSwap:
+			return queryRouteSwap(ctx, req, keeper)
+		case types.QuerySwapSimulation:
+			return querySwapSimulation(ctx, req, keeper)
 		case types.QueryPrevDayIssuance:
 			return queryPrevDayIssuance(ctx, req, keeper)
+		case types.QueryIssuanceHistory:
+			return queryIssuanceHistory(ctx, keeper)
+		case types.QueryTerraPoolDelta:
+			return queryTerraPoolDelta(ctx, keeper)
+		case types.QueryLimitOrder:
+			return queryLimitOrder(ctx, req, keeper)
+		case types.QueryLimitOrders:
+			return queryLimitOrders(ctx, req, keeper)
+		case types.QuerySwapSchedule:
+			return querySwapSchedule(ctx, req, keeper)
+		case types.QuerySwapSchedules:
+			return querySwapSchedules(ctx, req, keeper)
+		case types.QueryTobinTax:
+			return queryTobinTax(ctx, req, keeper)
+		case types.QuerySwapFeeProceeds:
+			return querySwapFeeProceeds(ctx, req, keeper)
+		case types.QuerySwapVolume:
+			return querySwapVolume(ctx, req, keeper)
+		case types.QueryTraderSwapVolume:
+			return queryTraderSwapVolume(ctx, req, keeper)
 		case types.QueryParameters:
 			return queryParameters(ctx, keeper)
 		default:
@@ -56,9 +85,248 @@ func querySwap(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, s
 	return bz, nil
 }
 
+func queryRouteSwap(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryRouteSwapParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	if err := types.ValidateSwapRoute(params.OfferCoin.Denom, params.AskDenoms); err != nil {
+		return nil, err
+	}
+
+	hops, err2 := keeper.SimulateRouteSwap(ctx, params.OfferCoin, params.AskDenoms)
+	if err2 != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("Failed to simulate route swap", err2.Error()))
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, hops)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func querySwapSimulation(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySwapParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	simulation, err2 := keeper.SimulateSwap(ctx, params.OfferCoin, params.AskDenom)
+	if err2 != nil {
+		return nil, err2
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, simulation)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
 func queryPrevDayIssuance(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 
-	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetPrevDayIssuance(ctx))
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetWindowBaseIssuance(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryIssuanceHistory(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetIssuanceBuckets(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryTerraPoolDelta(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetTerraPoolDelta(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryLimitOrder(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryLimitOrderParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	order, err2 := keeper.GetLimitOrder(ctx, params.OrderID)
+	if err2 != nil {
+		return nil, err2
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, order)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryLimitOrders(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryLimitOrdersParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	orders := types.LimitOrders{}
+	keeper.IterateLimitOrders(ctx, func(order types.LimitOrder) (stop bool) {
+		if params.Trader.Empty() || params.Trader.Equals(order.Trader) {
+			orders = append(orders, order)
+		}
+		return false
+	})
+
+	start, end := client.Paginate(len(orders), params.Page, params.Limit, defaultQueryLimit)
+	if start < 0 || end < 0 {
+		orders = types.LimitOrders{}
+	} else {
+		orders = orders[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, orders)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func querySwapSchedule(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySwapScheduleParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	schedule, err2 := keeper.GetSwapSchedule(ctx, params.ScheduleID)
+	if err2 != nil {
+		return nil, err2
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, schedule)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func querySwapSchedules(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySwapSchedulesParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	schedules := types.SwapSchedules{}
+	keeper.IterateSwapSchedules(ctx, func(schedule types.SwapSchedule) (stop bool) {
+		if params.Trader.Empty() || params.Trader.Equals(schedule.Trader) {
+			schedules = append(schedules, schedule)
+		}
+		return false
+	})
+
+	start, end := client.Paginate(len(schedules), params.Page, params.Limit, defaultQueryLimit)
+	if start < 0 || end < 0 {
+		schedules = types.SwapSchedules{}
+	} else {
+		schedules = schedules[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, schedules)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryTobinTax(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTobinTaxParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	if params.OfferDenom == params.AskDenom {
+		return nil, types.ErrRecursiveSwap(types.DefaultCodespace, params.AskDenom)
+	}
+
+	// swaps involving Luna are charged a spread instead
+	if params.OfferDenom == core.MicroLunaDenom || params.AskDenom == core.MicroLunaDenom {
+		return nil, sdk.ErrUnknownRequest("Tobin tax only applies to Terra<>Terra swaps")
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetTobinTax(ctx, params.OfferDenom, params.AskDenom))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func querySwapFeeProceeds(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySwapFeeProceedsParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	curEpoch := core.GetEpoch(ctx)
+	if 0 > params.Epoch || curEpoch < params.Epoch {
+		return nil, types.ErrInvalidEpoch(types.DefaultCodespace, curEpoch, params.Epoch)
+	}
+
+	proceeds := keeper.PeekSwapFeeProceeds(ctx, params.Epoch)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, proceeds)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func querySwapVolume(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySwapVolumeParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	curEpoch := core.GetEpoch(ctx)
+	if 0 > params.Epoch || curEpoch < params.Epoch {
+		return nil, types.ErrInvalidEpoch(types.DefaultCodespace, curEpoch, params.Epoch)
+	}
+
+	volume := keeper.PeekSwapVolume(ctx, params.Epoch)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, volume)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryTraderSwapVolume(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTraderSwapVolumeParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	curEpoch := core.GetEpoch(ctx)
+	if 0 > params.Epoch || curEpoch < params.Epoch {
+		return nil, types.ErrInvalidEpoch(types.DefaultCodespace, curEpoch, params.Epoch)
+	}
+
+	volume := keeper.PeekTraderSwapVolume(ctx, params.Epoch, params.Trader)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, volume)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 	}
@@ -67,3 +67,308 @@ func TestQuerySwap(t *testing.T) {
 	require.Equal(t, core.MicroSDRDenom, swapCoin.Denom)
 	require.Equal(t, sdk.NewInt(17), swapCoin.Amount)
 }
+
+func TestQueryTerraPoolDelta(t *testing.T) {
+	cdc := codec.New()
+	input := CreateTestInput(t)
+
+	poolDelta := sdk.NewDecWithPrec(17, 1)
+	input.MarketKeeper.SetTerraPoolDelta(input.Ctx, poolDelta)
+
+	res, errRes := queryTerraPoolDelta(input.Ctx, input.MarketKeeper)
+	require.NoError(t, errRes)
+
+	var retPoolDelta sdk.Dec
+	err := cdc.UnmarshalJSON(res, &retPoolDelta)
+	require.NoError(t, err)
+	require.Equal(t, poolDelta, retPoolDelta)
+}
+
+func TestQueryRouteSwap(t *testing.T) {
+	cdc := codec.New()
+	input := CreateTestInput(t)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(17, 1))
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroKRWDenom, sdk.NewDec(2000))
+
+	querier := NewQuerier(input.MarketKeeper)
+	offerCoin := sdk.NewCoin(core.MicroKRWDenom, sdk.NewInt(2000*core.MicroUnit))
+	askDenoms := []string{core.MicroSDRDenom, core.MicroLunaDenom}
+
+	// empty route fails
+	bz, err := cdc.MarshalJSON(types.NewQueryRouteSwapParams(offerCoin, []string{}))
+	require.NoError(t, err)
+
+	query := abci.RequestQuery{
+		Path: "",
+		Data: bz,
+	}
+
+	_, err = querier(input.Ctx, []string{types.QueryRouteSwap}, query)
+	require.Error(t, err)
+
+	bz, err = cdc.MarshalJSON(types.NewQueryRouteSwapParams(offerCoin, askDenoms))
+	require.NoError(t, err)
+
+	query = abci.RequestQuery{
+		Path: "",
+		Data: bz,
+	}
+
+	res, err := querier(input.Ctx, []string{types.QueryRouteSwap}, query)
+	require.NoError(t, err)
+
+	var hops types.SwapHops
+	err = cdc.UnmarshalJSON(res, &hops)
+	require.NoError(t, err)
+
+	expectedHops, err := input.MarketKeeper.SimulateRouteSwap(input.Ctx, offerCoin, askDenoms)
+	require.NoError(t, err)
+	require.Equal(t, expectedHops, hops)
+}
+
+func TestQueryLimitOrders(t *testing.T) {
+	cdc := codec.New()
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.MarketKeeper)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	var orders []types.LimitOrder
+	for i := 0; i < 5; i++ {
+		order := input.MarketKeeper.AddLimitOrder(input.Ctx,
+			types.NewLimitOrder(0, Addrs[i%2], offerCoin, core.MicroSDRDenom, sdk.OneDec(), 10))
+		orders = append(orders, order)
+	}
+
+	// single order
+	bz, err := cdc.MarshalJSON(types.NewQueryLimitOrderParams(3))
+	require.NoError(t, err)
+
+	res, err := querier(input.Ctx, []string{types.QueryLimitOrder}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var order types.LimitOrder
+	require.NoError(t, cdc.UnmarshalJSON(res, &order))
+	require.Equal(t, orders[2], order)
+
+	bz, err = cdc.MarshalJSON(types.NewQueryLimitOrderParams(100))
+	require.NoError(t, err)
+
+	_, err = querier(input.Ctx, []string{types.QueryLimitOrder}, abci.RequestQuery{Data: bz})
+	require.Error(t, err)
+
+	// paginated orders
+	bz, err = cdc.MarshalJSON(types.NewQueryLimitOrdersParams(nil, 2, 2))
+	require.NoError(t, err)
+
+	res, err = querier(input.Ctx, []string{types.QueryLimitOrders}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var retOrders types.LimitOrders
+	require.NoError(t, cdc.UnmarshalJSON(res, &retOrders))
+	require.Equal(t, types.LimitOrders{orders[2], orders[3]}, retOrders)
+
+	// orders of a trader
+	bz, err = cdc.MarshalJSON(types.NewQueryLimitOrdersParams(Addrs[1], 1, 10))
+	require.NoError(t, err)
+
+	res, err = querier(input.Ctx, []string{types.QueryLimitOrders}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	retOrders = types.LimitOrders{}
+	require.NoError(t, cdc.UnmarshalJSON(res, &retOrders))
+	require.Equal(t, types.LimitOrders{orders[1], orders[3]}, retOrders)
+
+	// page out of range
+	bz, err = cdc.MarshalJSON(types.NewQueryLimitOrdersParams(nil, 10, 10))
+	require.NoError(t, err)
+
+	res, err = querier(input.Ctx, []string{types.QueryLimitOrders}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	retOrders = types.LimitOrders{}
+	require.NoError(t, cdc.UnmarshalJSON(res, &retOrders))
+	require.Equal(t, 0, len(retOrders))
+}
+
+func TestQuerySwapSchedules(t *testing.T) {
+	cdc := codec.New()
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.MarketKeeper)
+
+	offerCoin := sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(10))
+	var schedules []types.SwapSchedule
+	for i := 0; i < 5; i++ {
+		schedule := input.MarketKeeper.AddSwapSchedule(input.Ctx,
+			types.NewSwapSchedule(0, Addrs[i%2], offerCoin, core.MicroKRWDenom, 10, 5, 10))
+		schedules = append(schedules, schedule)
+	}
+
+	// single schedule
+	bz, err := cdc.MarshalJSON(types.NewQuerySwapScheduleParams(3))
+	require.NoError(t, err)
+
+	res, err := querier(input.Ctx, []string{types.QuerySwapSchedule}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var schedule types.SwapSchedule
+	require.NoError(t, cdc.UnmarshalJSON(res, &schedule))
+	require.Equal(t, schedules[2], schedule)
+
+	bz, err = cdc.MarshalJSON(types.NewQuerySwapScheduleParams(100))
+	require.NoError(t, err)
+
+	_, err = querier(input.Ctx, []string{types.QuerySwapSchedule}, abci.RequestQuery{Data: bz})
+	require.Error(t, err)
+
+	// schedules of a trader, paginated
+	bz, err = cdc.MarshalJSON(types.NewQuerySwapSchedulesParams(Addrs[0], 2, 2))
+	require.NoError(t, err)
+
+	res, err = querier(input.Ctx, []string{types.QuerySwapSchedules}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var retSchedules types.SwapSchedules
+	require.NoError(t, cdc.UnmarshalJSON(res, &retSchedules))
+	require.Equal(t, types.SwapSchedules{schedules[4]}, retSchedules)
+}
+
+func TestQueryTobinTax(t *testing.T) {
+	cdc := codec.New()
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.MarketKeeper)
+
+	bz, err := cdc.MarshalJSON(types.NewQueryTobinTaxParams(core.MicroKRWDenom, core.MicroSDRDenom))
+	require.NoError(t, err)
+
+	res, err := querier(input.Ctx, []string{types.QueryTobinTax}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var taxRate sdk.Dec
+	require.NoError(t, cdc.UnmarshalJSON(res, &taxRate))
+	require.Equal(t, input.MarketKeeper.TobinTax(input.Ctx), taxRate)
+
+	// swaps involving Luna are not charged the Tobin tax
+	bz, err = cdc.MarshalJSON(types.NewQueryTobinTaxParams(core.MicroKRWDenom, core.MicroLunaDenom))
+	require.NoError(t, err)
+
+	_, err = querier(input.Ctx, []string{types.QueryTobinTax}, abci.RequestQuery{Data: bz})
+	require.Error(t, err)
+}
+
+func TestQuerySwapFeeProceeds(t *testing.T) {
+	cdc := codec.New()
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.MarketKeeper)
+
+	fee := sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(100)))
+	input.MarketKeeper.RecordSwapFeeProceeds(input.Ctx, fee)
+
+	bz, err := cdc.MarshalJSON(types.NewQuerySwapFeeProceedsParams(0))
+	require.NoError(t, err)
+
+	res, err := querier(input.Ctx, []string{types.QuerySwapFeeProceeds}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var proceeds sdk.Coins
+	require.NoError(t, cdc.UnmarshalJSON(res, &proceeds))
+	require.Equal(t, fee, proceeds)
+
+	// future epoch is not queryable
+	bz, err = cdc.MarshalJSON(types.NewQuerySwapFeeProceedsParams(1))
+	require.NoError(t, err)
+
+	_, err = querier(input.Ctx, []string{types.QuerySwapFeeProceeds}, abci.RequestQuery{Data: bz})
+	require.Error(t, err)
+}
+
+func TestQuerySwapVolume(t *testing.T) {
+	cdc := codec.New()
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.MarketKeeper)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.TraderVolume = true
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	offerCoin := sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(100))
+	swapCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(50))
+	input.MarketKeeper.RecordSwapVolume(input.Ctx, Addrs[0], offerCoin, swapCoin)
+
+	bz, err := cdc.MarshalJSON(types.NewQuerySwapVolumeParams(0))
+	require.NoError(t, err)
+
+	res, err := querier(input.Ctx, []string{types.QuerySwapVolume}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var volume sdk.Coins
+	require.NoError(t, cdc.UnmarshalJSON(res, &volume))
+	require.Equal(t, sdk.NewCoins(offerCoin, swapCoin), volume)
+
+	bz, err = cdc.MarshalJSON(types.NewQueryTraderSwapVolumeParams(0, Addrs[0]))
+	require.NoError(t, err)
+
+	res, err = querier(input.Ctx, []string{types.QueryTraderSwapVolume}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var traderVolume sdk.Coins
+	require.NoError(t, cdc.UnmarshalJSON(res, &traderVolume))
+	require.Equal(t, sdk.NewCoins(offerCoin, swapCoin), traderVolume)
+
+	// future epoch is not queryable
+	bz, err = cdc.MarshalJSON(types.NewQuerySwapVolumeParams(1))
+	require.NoError(t, err)
+
+	_, err = querier(input.Ctx, []string{types.QuerySwapVolume}, abci.RequestQuery{Data: bz})
+	require.Error(t, err)
+
+	bz, err = cdc.MarshalJSON(types.NewQueryTraderSwapVolumeParams(1, Addrs[0]))
+	require.NoError(t, err)
+
+	_, err = querier(input.Ctx, []string{types.QueryTraderSwapVolume}, abci.RequestQuery{Data: bz})
+	require.Error(t, err)
+}
+
+func TestQueryIssuanceHistory(t *testing.T) {
+	cdc := codec.New()
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.MarketKeeper)
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerHour - 1)
+	issuance := input.MarketKeeper.RecordHourlyIssuance(input.Ctx)
+
+	res, err := querier(input.Ctx, []string{types.QueryIssuanceHistory}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var buckets types.IssuanceBuckets
+	require.NoError(t, cdc.UnmarshalJSON(res, &buckets))
+	require.Equal(t, types.IssuanceBuckets{types.NewIssuanceBucket(0, issuance)}, buckets)
+}
+
+func TestQuerySwapSimulation(t *testing.T) {
+	cdc := codec.New()
+	input := CreateTestInput(t)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(17, 1))
+
+	querier := NewQuerier(input.MarketKeeper)
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(core.MicroUnit))
+
+	bz, err := cdc.MarshalJSON(types.NewQuerySwapParams(offerCoin, core.MicroSDRDenom))
+	require.NoError(t, err)
+
+	res, err := querier(input.Ctx, []string{types.QuerySwapSimulation}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var simulation types.SwapSimulation
+	require.NoError(t, cdc.UnmarshalJSON(res, &simulation))
+
+	expectedSimulation, err := input.MarketKeeper.SimulateSwap(input.Ctx, offerCoin, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, expectedSimulation, simulation)
+
+	// swap without a price fails
+	bz, err = cdc.MarshalJSON(types.NewQuerySwapParams(offerCoin, core.MicroKRWDenom))
+	require.NoError(t, err)
+
+	_, err = querier(input.Ctx, []string{types.QuerySwapSimulation}, abci.RequestQuery{Data: bz})
+	require.Error(t, err)
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/market/internal/types"
+)
+
+// GetNextSwapScheduleID returns the ID to be assigned to the next swap schedule
+func (k Keeper) GetNextSwapScheduleID(ctx sdk.Context) (scheduleID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextSwapScheduleIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &scheduleID)
+	return
+}
+
+// SetNextSwapScheduleID stores the ID to be assigned to the next swap schedule
+func (k Keeper) SetNextSwapScheduleID(ctx sdk.Context, scheduleID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(scheduleID)
+	store.Set(types.NextSwapScheduleIDKey, bz)
+}
+
+// GetSwapSchedule retrieves a swap schedule from the store
+func (k Keeper) GetSwapSchedule(ctx sdk.Context, scheduleID uint64) (schedule types.SwapSchedule, err sdk.Error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSwapScheduleKey(scheduleID))
+	if bz == nil {
+		err = types.ErrNoSwapSchedule(k.codespace, scheduleID)
+		return
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &schedule)
+	return
+}
+
+// SetSwapSchedule stores a swap schedule, and queues it by its next execution height
+func (k Keeper) SetSwapSchedule(ctx sdk.Context, schedule types.SwapSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	if prev, err := k.GetSwapSchedule(ctx, schedule.ScheduleID); err == nil {
+		store.Delete(types.GetSwapScheduleQueueKey(prev.NextHeight, prev.ScheduleID))
+	}
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(schedule)
+	store.Set(types.GetSwapScheduleKey(schedule.ScheduleID), bz)
+	store.Set(types.GetSwapScheduleQueueKey(schedule.NextHeight, schedule.ScheduleID), []byte{})
+}
+
+// DeleteSwapSchedule deletes a swap schedule and its queue entry from the store
+func (k Keeper) DeleteSwapSchedule(ctx sdk.Context, scheduleID uint64) {
+	schedule, err := k.GetSwapSchedule(ctx, scheduleID)
+	if err != nil {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSwapScheduleKey(scheduleID))
+	store.Delete(types.GetSwapScheduleQueueKey(schedule.NextHeight, scheduleID))
+}
+
+// AddSwapSchedule assigns the next ID to a new swap schedule and stores it
+func (k Keeper) AddSwapSchedule(ctx sdk.Context, schedule types.SwapSchedule) types.SwapSchedule {
+	schedule.ScheduleID = k.GetNextSwapScheduleID(ctx)
+	k.SetNextSwapScheduleID(ctx, schedule.ScheduleID+1)
+	k.SetSwapSchedule(ctx, schedule)
+
+	return schedule
+}
+
+// IterateSwapSchedules iterates over swap schedules in creation order
+func (k Keeper) IterateSwapSchedules(ctx sdk.Context, handler func(schedule types.SwapSchedule) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SwapScheduleKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var schedule types.SwapSchedule
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &schedule)
+		if handler(schedule) {
+			break
+		}
+	}
+}
+
+// IterateDueSwapSchedules iterates over swap schedules due at the given block height,
+// from the earliest next execution height, then in creation order
+func (k Keeper) IterateDueSwapSchedules(ctx sdk.Context, blockHeight int64, handler func(schedule types.SwapSchedule) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.SwapScheduleQueueKey, types.GetSwapScheduleQueuePrefixKey(blockHeight+1))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		scheduleID := binary.BigEndian.Uint64(iter.Key()[len(types.GetSwapScheduleQueuePrefixKey(0)):])
+		schedule, err := k.GetSwapSchedule(ctx, scheduleID)
+		if err != nil {
+			continue
+		}
+
+		if handler(schedule) {
+			break
+		}
+	}
+}
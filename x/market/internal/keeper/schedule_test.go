@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/market/internal/types"
+)
+
+func TestSwapScheduleUpdate(t *testing.T) {
+	input := CreateTestInput(t)
+
+	require.Equal(t, uint64(1), input.MarketKeeper.GetNextSwapScheduleID(input.Ctx))
+
+	_, err := input.MarketKeeper.GetSwapSchedule(input.Ctx, 1)
+	require.Error(t, err)
+
+	offerCoin := sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(10))
+	for i := 0; i < 3; i++ {
+		schedule := input.MarketKeeper.AddSwapSchedule(input.Ctx,
+			types.NewSwapSchedule(0, Addrs[i], offerCoin, core.MicroKRWDenom, 10, 5, 10))
+		require.Equal(t, uint64(i+1), schedule.ScheduleID)
+	}
+
+	require.Equal(t, uint64(4), input.MarketKeeper.GetNextSwapScheduleID(input.Ctx))
+
+	schedule, err := input.MarketKeeper.GetSwapSchedule(input.Ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, Addrs[1], schedule.Trader)
+	require.Equal(t, sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(50)), schedule.Escrow())
+
+	input.MarketKeeper.DeleteSwapSchedule(input.Ctx, 2)
+	_, err = input.MarketKeeper.GetSwapSchedule(input.Ctx, 2)
+	require.Error(t, err)
+
+	// schedules iterate in creation order
+	var scheduleIDs []uint64
+	input.MarketKeeper.IterateSwapSchedules(input.Ctx, func(schedule types.SwapSchedule) (stop bool) {
+		scheduleIDs = append(scheduleIDs, schedule.ScheduleID)
+		return false
+	})
+	require.Equal(t, []uint64{1, 3}, scheduleIDs)
+
+	// deleted IDs are not reused
+	schedule = input.MarketKeeper.AddSwapSchedule(input.Ctx,
+		types.NewSwapSchedule(0, Addrs[0], offerCoin, core.MicroKRWDenom, 10, 5, 10))
+	require.Equal(t, uint64(4), schedule.ScheduleID)
+}
+
+func TestSwapScheduleQueue(t *testing.T) {
+	input := CreateTestInput(t)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	for _, nextHeight := range []int64{30, 10, 20, 10} {
+		input.MarketKeeper.AddSwapSchedule(input.Ctx,
+			types.NewSwapSchedule(0, Addrs[0], offerCoin, core.MicroSDRDenom, 10, 5, nextHeight))
+	}
+
+	dueScheduleIDs := func(blockHeight int64) (scheduleIDs []uint64) {
+		input.MarketKeeper.IterateDueSwapSchedules(input.Ctx, blockHeight, func(schedule types.SwapSchedule) (stop bool) {
+			scheduleIDs = append(scheduleIDs, schedule.ScheduleID)
+			return false
+		})
+		return
+	}
+
+	// due schedules iterate from the earliest next height
+	require.Empty(t, dueScheduleIDs(9))
+	require.Equal(t, []uint64{2, 4}, dueScheduleIDs(10))
+	require.Equal(t, []uint64{2, 4, 3}, dueScheduleIDs(29))
+
+	// moving a schedule to its next execution requeues it
+	schedule, err := input.MarketKeeper.GetSwapSchedule(input.Ctx, 2)
+	require.NoError(t, err)
+	schedule.NextHeight = 40
+	input.MarketKeeper.SetSwapSchedule(input.Ctx, schedule)
+	require.Equal(t, []uint64{4, 3, 1}, dueScheduleIDs(39))
+
+	// deleted schedules are dropped from the queue
+	input.MarketKeeper.DeleteSwapSchedule(input.Ctx, 4)
+	require.Equal(t, []uint64{3, 1, 2}, dueScheduleIDs(40))
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/market/internal/types"
+)
+
+// ApplySwap computes the swap of offerCoin to askDenom with the spread fee charged,
+// and updates the virtual pools with it. Balances are left for the caller to settle.
+func (k Keeper) ApplySwap(ctx sdk.Context, offerCoin sdk.Coin, askDenom string) (types.SwapHop, sdk.Error) {
+	// Can't swap to the same coin
+	if offerCoin.Denom == askDenom {
+		return types.SwapHop{}, types.ErrRecursiveSwap(k.codespace, askDenom)
+	}
+
+	// Refuse swaps while an oracle price is out of its band
+	err := k.CheckPriceSettled(ctx, offerCoin.Denom, askDenom)
+	if err != nil {
+		return types.SwapHop{}, err
+	}
+
+	// Compute exchange rates between the ask and offer
+	swapCoin, spread, err := k.GetSwapCoin(ctx, offerCoin, askDenom, false)
+	if err != nil {
+		return types.SwapHop{}, err
+	}
+
+	// Refuse Luna swaps while the circuit breaker is tripped
+	err = k.CheckCircuitBreaker(ctx, offerCoin, swapCoin)
+	if err != nil {
+		return types.SwapHop{}, err
+	}
+
+	// Update the virtual pools with the swap; no-op for Terra<>Terra swaps
+	err = k.ApplySwapToPool(ctx, offerCoin, swapCoin)
+	if err != nil {
+		return types.SwapHop{}, err
+	}
+
+	// Charge a spread if applicable
+	swapFee := sdk.NewCoin(askDenom, sdk.ZeroInt())
+	if spread.IsPositive() {
+		swapFeeAmt := spread.MulInt(swapCoin.Amount).TruncateInt()
+		if swapFeeAmt.IsPositive() {
+			swapFee = sdk.NewCoin(askDenom, swapFeeAmt)
+			swapCoin = swapCoin.Sub(swapFee)
+		}
+	}
+
+	return types.NewSwapHop(offerCoin, swapCoin, swapFee), nil
+}
+
+// CheckPriceSettled returns an error if the oracle price of any of the denoms moved beyond
+// the oracle price band, and has not settled back within it yet
+func (k Keeper) CheckPriceSettled(ctx sdk.Context, denoms ...string) sdk.Error {
+	for _, denom := range denoms {
+		if k.oracleKeeper.IsPriceUnsettled(ctx, denom) {
+			return types.ErrPriceUnsettled(k.codespace, denom)
+		}
+	}
+
+	return nil
+}
+
+// SimulateRouteSwap returns the hops of swapping offerCoin through the ask denoms in order, without
+// committing any state. Each hop is charged a spread only if it involves Luna, and sees the virtual
+// pools as updated by the hops before it.
+func (k Keeper) SimulateRouteSwap(ctx sdk.Context, offerCoin sdk.Coin, askDenoms []string) (types.SwapHops, sdk.Error) {
+	cacheCtx, _ := ctx.CacheContext()
+
+	hops := make(types.SwapHops, 0, len(askDenoms))
+	for _, askDenom := range askDenoms {
+		hop, err := k.ApplySwap(cacheCtx, offerCoin, askDenom)
+		if err != nil {
+			return nil, err
+		}
+
+		hops = append(hops, hop)
+		offerCoin = hop.SwapCoin
+	}
+
+	return hops, nil
+}
+
+// SimulateSwap returns the breakdown of swapping offerCoin to askDenom at the current exchange rates,
+// without committing any state
+func (k Keeper) SimulateSwap(ctx sdk.Context, offerCoin sdk.Coin, askDenom string) (types.SwapSimulation, sdk.Error) {
+	if offerCoin.Denom == askDenom {
+		return types.SwapSimulation{}, types.ErrRecursiveSwap(k.codespace, askDenom)
+	}
+
+	if err := k.CheckPriceSettled(ctx, offerCoin.Denom, askDenom); err != nil {
+		return types.SwapSimulation{}, err
+	}
+
+	offerRate, err := k.GetSwapRate(ctx, offerCoin.Denom)
+	if err != nil {
+		return types.SwapSimulation{}, err
+	}
+
+	askRate, err := k.GetSwapRate(ctx, askDenom)
+	if err != nil {
+		return types.SwapSimulation{}, err
+	}
+
+	swapCoin, spread, err := k.GetSwapCoin(ctx, offerCoin, askDenom, false)
+	if err != nil {
+		return types.SwapSimulation{}, err
+	}
+
+	if err := k.CheckCircuitBreaker(ctx, offerCoin, swapCoin); err != nil {
+		return types.SwapSimulation{}, err
+	}
+
+	// Luna supply changes only with swaps involving Luna
+	lunaChange := sdk.ZeroInt()
+	if offerCoin.Denom == core.MicroLunaDenom {
+		lunaChange = offerCoin.Amount.Neg()
+	} else if askDenom == core.MicroLunaDenom {
+		lunaChange = swapCoin.Amount
+	}
+
+	swapFee := sdk.NewCoin(askDenom, spread.MulInt(swapCoin.Amount).TruncateInt())
+
+	return types.SwapSimulation{
+		OfferCoin:       offerCoin,
+		OfferRate:       offerRate,
+		AskRate:         askRate,
+		LunaDeltaBefore: k.ComputeLunaDelta(ctx, sdk.ZeroInt()),
+		LunaDeltaAfter:  k.ComputeLunaDelta(ctx, lunaChange),
+		Spread:          spread,
+		SwapFee:         swapFee,
+		ReturnCoin:      swapCoin.Sub(swapFee),
+	}, nil
+}
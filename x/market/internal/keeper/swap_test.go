@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/market/internal/types"
+)
+
+func TestApplySwap(t *testing.T) {
+	input := CreateTestInput(t)
+	lunaPriceInSDR := sdk.NewDecWithPrec(17, 1)
+	lunaPriceInKRW := sdk.NewDec(2000)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, lunaPriceInSDR)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroKRWDenom, lunaPriceInKRW)
+
+	// Terra<>Terra swap is charged the Tobin tax and does not move the pools
+	offerCoin := sdk.NewCoin(core.MicroKRWDenom, sdk.NewInt(2000*core.MicroUnit))
+	hop, err := input.MarketKeeper.ApplySwap(input.Ctx, offerCoin, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, offerCoin, hop.OfferCoin)
+
+	swapAmt := lunaPriceInSDR.MulInt64(core.MicroUnit).TruncateInt()
+	tobinTaxAmt := input.MarketKeeper.TobinTax(input.Ctx).MulInt(swapAmt).TruncateInt()
+	require.Equal(t, sdk.NewCoin(core.MicroSDRDenom, swapAmt.Sub(tobinTaxAmt)), hop.SwapCoin)
+	require.Equal(t, sdk.NewCoin(core.MicroSDRDenom, tobinTaxAmt), hop.SwapFee)
+	require.True(t, input.MarketKeeper.GetTerraPoolDelta(input.Ctx).IsZero())
+
+	// Terra->Luna swap is charged a spread, and fills the terra pool
+	hop, err = input.MarketKeeper.ApplySwap(input.Ctx, offerCoin, core.MicroLunaDenom)
+	require.NoError(t, err)
+	require.True(t, hop.SwapFee.IsPositive())
+	require.Equal(t, sdk.NewInt(core.MicroUnit), hop.SwapCoin.Amount.Add(hop.SwapFee.Amount))
+	require.Equal(t, lunaPriceInSDR.MulInt64(core.MicroUnit), input.MarketKeeper.GetTerraPoolDelta(input.Ctx))
+
+	// recursive swap fails
+	_, err = input.MarketKeeper.ApplySwap(input.Ctx, offerCoin, core.MicroKRWDenom)
+	require.Error(t, err)
+}
+
+func TestSimulateRouteSwap(t *testing.T) {
+	input := CreateTestInput(t)
+	lunaPriceInSDR := sdk.NewDecWithPrec(17, 1)
+	lunaPriceInKRW := sdk.NewDec(2000)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, lunaPriceInSDR)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroKRWDenom, lunaPriceInKRW)
+
+	offerCoin := sdk.NewCoin(core.MicroKRWDenom, sdk.NewInt(2000*core.MicroUnit))
+	hops, err := input.MarketKeeper.SimulateRouteSwap(input.Ctx, offerCoin, []string{core.MicroSDRDenom, core.MicroLunaDenom})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(hops))
+
+	// each hop offers the coin swapped by the hop before it
+	require.Equal(t, offerCoin, hops[0].OfferCoin)
+	require.Equal(t, hops[0].SwapCoin, hops[1].OfferCoin)
+	require.Equal(t, core.MicroLunaDenom, hops[1].SwapCoin.Denom)
+
+	// the Terra<>Terra hop is charged the Tobin tax, and the hop crossing the Luna boundary a spread
+	tobinTaxAmt := input.MarketKeeper.TobinTax(input.Ctx).MulInt(hops[0].SwapCoin.Amount.Add(hops[0].SwapFee.Amount)).TruncateInt()
+	require.Equal(t, tobinTaxAmt, hops[0].SwapFee.Amount)
+	require.True(t, hops[1].SwapFee.IsPositive())
+
+	// simulation does not commit the pool updates
+	require.True(t, input.MarketKeeper.GetTerraPoolDelta(input.Ctx).IsZero())
+
+	// an unknown denom on the route fails the whole simulation
+	_, err = input.MarketKeeper.SimulateRouteSwap(input.Ctx, offerCoin, []string{core.MicroSDRDenom, core.MicroUSDDenom})
+	require.Error(t, err)
+}
+
+func TestSimulateSwap(t *testing.T) {
+	input := CreateTestInput(t)
+	lunaPriceInSDR := sdk.NewDecWithPrec(17, 1)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.SpreadModel = types.SpreadModelLinear
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	issuance := input.MarketKeeper.RecordHourlyIssuance(input.Ctx).AmountOf(core.MicroLunaDenom)
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerHour)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, lunaPriceInSDR)
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, issuance.QuoRaw(1000))
+	simulation, err := input.MarketKeeper.SimulateSwap(input.Ctx, offerCoin, core.MicroSDRDenom)
+	require.NoError(t, err)
+
+	require.Equal(t, offerCoin, simulation.OfferCoin)
+	require.Equal(t, sdk.OneDec(), simulation.OfferRate)
+	require.Equal(t, lunaPriceInSDR, simulation.AskRate)
+	require.True(t, simulation.LunaDeltaBefore.IsZero())
+	require.Equal(t, sdk.NewDecWithPrec(-1, 3), simulation.LunaDeltaAfter)
+	require.Equal(t, input.MarketKeeper.ComputeLunaSwapSpread(input.Ctx, core.MicroSDRDenom, simulation.LunaDeltaAfter.Abs()), simulation.Spread)
+
+	// the simulation matches the swap, and commits nothing
+	cacheCtx, _ := input.Ctx.CacheContext()
+	hop, err := input.MarketKeeper.ApplySwap(cacheCtx, offerCoin, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, hop.SwapCoin, simulation.ReturnCoin)
+	require.Equal(t, hop.SwapFee, simulation.SwapFee)
+	require.True(t, input.MarketKeeper.GetTerraPoolDelta(input.Ctx).IsZero())
+
+	// swap without a price fails
+	_, err = input.MarketKeeper.SimulateSwap(input.Ctx, offerCoin, core.MicroKRWDenom)
+	require.Error(t, err)
+
+	// recursive swap fails
+	_, err = input.MarketKeeper.SimulateSwap(input.Ctx, offerCoin, core.MicroLunaDenom)
+	require.Error(t, err)
+
+	// swap refused by the circuit breaker fails
+	limit := input.MarketKeeper.LunaDeltaHardLimit(input.Ctx)
+	bigOfferCoin := sdk.NewCoin(core.MicroLunaDenom, limit.MulInt64(2).MulInt(issuance).TruncateInt())
+	_, err = input.MarketKeeper.SimulateSwap(input.Ctx, bigOfferCoin, core.MicroSDRDenom)
+	require.Equal(t, types.CodeCircuitBreaker, err.Code())
+
+	input.MarketKeeper.SetSwapHalted(input.Ctx, true)
+	_, err = input.MarketKeeper.SimulateSwap(input.Ctx, offerCoin, core.MicroSDRDenom)
+	require.Equal(t, types.CodeCircuitBreaker, err.Code())
+}
+
+func TestApplySwapPriceUnsettled(t *testing.T) {
+	input := CreateTestInput(t)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(17, 1))
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroKRWDenom, sdk.NewDec(2000))
+	input.OracleKeeper.SetUnsettledPeriods(input.Ctx, core.MicroKRWDenom, 1)
+
+	// swaps offering or asking the unsettled denom are refused
+	krwCoin := sdk.NewCoin(core.MicroKRWDenom, sdk.NewInt(2000*core.MicroUnit))
+	_, err := input.MarketKeeper.ApplySwap(input.Ctx, krwCoin, core.MicroSDRDenom)
+	require.Equal(t, types.CodePriceUnsettled, err.Code())
+
+	sdrCoin := sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(core.MicroUnit))
+	_, err = input.MarketKeeper.ApplySwap(input.Ctx, sdrCoin, core.MicroKRWDenom)
+	require.Equal(t, types.CodePriceUnsettled, err.Code())
+
+	_, err = input.MarketKeeper.SimulateSwap(input.Ctx, sdrCoin, core.MicroKRWDenom)
+	require.Equal(t, types.CodePriceUnsettled, err.Code())
+
+	// swaps of other denoms go through
+	_, err = input.MarketKeeper.ApplySwap(input.Ctx, sdrCoin, core.MicroLunaDenom)
+	require.NoError(t, err)
+
+	// swaps resume once the price settles
+	input.OracleKeeper.SetUnsettledPeriods(input.Ctx, core.MicroKRWDenom, 0)
+	_, err = input.MarketKeeper.ApplySwap(input.Ctx, sdrCoin, core.MicroKRWDenom)
+	require.NoError(t, err)
+}
+
+func TestApplySwapStalePrice(t *testing.T) {
+	input := CreateTestInput(t)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(17, 1))
+	votePeriod := input.OracleKeeper.VotePeriod(input.Ctx)
+
+	sdrCoin := sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(core.MicroUnit))
+	_, err := input.MarketKeeper.ApplySwap(input.Ctx.WithBlockHeight(votePeriod), sdrCoin, core.MicroLunaDenom)
+	require.NoError(t, err)
+
+	// the price goes stale once a tally has passed without updating it
+	_, err = input.MarketKeeper.ApplySwap(input.Ctx.WithBlockHeight(votePeriod+1), sdrCoin, core.MicroLunaDenom)
+	require.Equal(t, types.CodeStalePrice, err.Code())
+
+	_, err = input.MarketKeeper.SimulateSwap(input.Ctx.WithBlockHeight(votePeriod+1), sdrCoin, core.MicroLunaDenom)
+	require.Equal(t, types.CodeStalePrice, err.Code())
+
+	// unless older prices are accepted
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.MaxOraclePriceAge = 2
+	input.MarketKeeper.SetParams(input.Ctx, params)
+	_, err = input.MarketKeeper.ApplySwap(input.Ctx.WithBlockHeight(votePeriod+1), sdrCoin, core.MicroLunaDenom)
+	require.NoError(t, err)
+
+	// swap without a price is refused as such
+	_, err = input.MarketKeeper.ApplySwap(input.Ctx, sdrCoin, core.MicroKRWDenom)
+	require.Equal(t, types.CodeNoEffectivePrice, err.Code())
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 )
@@ -56,9 +57,11 @@ var (
 type TestInput struct {
 	Ctx          sdk.Context
 	Cdc          *codec.Codec
+	AccKeeper    auth.AccountKeeper
 	OracleKeeper oracle.Keeper
 	SupplyKeeper supply.Keeper
 	MarketKeeper Keeper
+	DistrKeeper  distr.Keeper
 }
 
 func newTestCodec() *codec.Codec {
@@ -86,6 +89,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	tKeyStaking := sdk.NewKVStoreKey(staking.TStoreKey)
 	keyDistr := sdk.NewKVStoreKey(distr.StoreKey)
 	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keySlashing := sdk.NewKVStoreKey(slashing.StoreKey)
 	keyMarket := sdk.NewKVStoreKey(types.StoreKey)
 
 	cdc := newTestCodec()
@@ -101,6 +105,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	ms.MountStoreWithDB(tKeyStaking, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyDistr, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySlashing, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMarket, sdk.StoreTypeIAVL, db)
 
 	require.NoError(t, ms.LoadLatestVersion())
@@ -111,6 +116,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		staking.BondedPoolName:    true,
 		distr.ModuleName:          true,
 		types.ModuleName:          true,
+		types.ScheduleEscrowName:  true,
 	}
 
 	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams, params.DefaultCodespace)
@@ -122,7 +128,9 @@ func CreateTestInput(t *testing.T) TestInput {
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 		staking.BondedPoolName:    {supply.Burner, supply.Staking},
 		distr.ModuleName:          nil,
+		oracle.ModuleName:         nil,
 		types.ModuleName:          {supply.Burner, supply.Minter},
+		types.ScheduleEscrowName:  nil,
 	}
 
 	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, maccPerms)
@@ -143,19 +151,30 @@ func CreateTestInput(t *testing.T) TestInput {
 		auth.FeeCollectorName, blackListAddrs,
 	)
 
+	distrKeeper.SetFeePool(ctx, distr.InitialFeePool())
+
+	slashingKeeper := slashing.NewKeeper(
+		cdc,
+		keySlashing, stakingKeeper,
+		paramsKeeper.Subspace(slashing.DefaultParamspace),
+		slashing.DefaultCodespace,
+	)
+
 	oracleKeeper := oracle.NewKeeper(
 		cdc,
 		keyOracle, paramsKeeper.Subspace(oracle.DefaultParamspace),
-		distrKeeper, stakingKeeper, supplyKeeper, distr.ModuleName,
+		distrKeeper, stakingKeeper, slashingKeeper, supplyKeeper, distr.ModuleName,
 		oracle.DefaultCodespace,
 	)
 
 	keeper := NewKeeper(
 		cdc,
 		keyMarket, paramsKeeper.Subspace(types.DefaultParamspace),
-		oracleKeeper, supplyKeeper, types.DefaultCodespace,
+		oracleKeeper, supplyKeeper, distrKeeper,
+		oracle.ModuleName, distr.ModuleName, types.DefaultCodespace,
 	)
 
+	oracleKeeper.SetParams(ctx, oracle.DefaultParams())
 	keeper.SetParams(ctx, types.DefaultParams())
 
 	feeCollectorAcc := supply.NewEmptyModuleAccount(auth.FeeCollectorName)
@@ -163,17 +182,19 @@ func CreateTestInput(t *testing.T) TestInput {
 	bondPool := supply.NewEmptyModuleAccount(staking.BondedPoolName, supply.Burner, supply.Staking)
 	distrAcc := supply.NewEmptyModuleAccount(distr.ModuleName)
 	marketAcc := supply.NewEmptyModuleAccount(types.ModuleName, supply.Burner, supply.Minter)
+	oracleAcc := supply.NewEmptyModuleAccount(oracle.ModuleName)
 
 	supplyKeeper.SetModuleAccount(ctx, feeCollectorAcc)
 	supplyKeeper.SetModuleAccount(ctx, bondPool)
 	supplyKeeper.SetModuleAccount(ctx, notBondedPool)
 	supplyKeeper.SetModuleAccount(ctx, distrAcc)
 	supplyKeeper.SetModuleAccount(ctx, marketAcc)
+	supplyKeeper.SetModuleAccount(ctx, oracleAcc)
 
 	for _, addr := range Addrs {
 		_, err := bankKeeper.AddCoins(ctx, sdk.AccAddress(addr), InitCoins)
 		require.NoError(t, err)
 	}
 
-	return TestInput{ctx, cdc, oracleKeeper, supplyKeeper, keeper}
+	return TestInput{ctx, cdc, accountKeeper, oracleKeeper, supplyKeeper, keeper, distrKeeper}
 }
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/market/internal/types"
+)
+
+// RecordSwapVolume adds the offered and the swapped coins of a swap to the swap volume of the current epoch,
+// and to the swap volume of the trader if TraderVolume is enabled
+func (k Keeper) RecordSwapVolume(ctx sdk.Context, trader sdk.AccAddress, offerCoin sdk.Coin, swapCoin sdk.Coin) {
+	volume := sdk.NewCoins(offerCoin).Add(sdk.NewCoins(swapCoin))
+	if volume.Empty() {
+		return
+	}
+
+	epoch := core.GetEpoch(ctx)
+	store := ctx.KVStore(k.storeKey)
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(k.PeekSwapVolume(ctx, epoch).Add(volume))
+	store.Set(types.GetSwapVolumeKey(epoch), bz)
+
+	if !k.TraderVolume(ctx) {
+		return
+	}
+
+	bz = k.cdc.MustMarshalBinaryLengthPrefixed(k.PeekTraderSwapVolume(ctx, epoch, trader).Add(volume))
+	store.Set(types.GetTraderSwapVolumeKey(epoch, trader), bz)
+}
+
+// PeekSwapVolume returns the swap volume per denom of the given epoch
+func (k Keeper) PeekSwapVolume(ctx sdk.Context, epoch int64) (res sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSwapVolumeKey(epoch))
+	if bz == nil {
+		return sdk.Coins{}
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &res)
+	return
+}
+
+// PeekTraderSwapVolume returns the swap volume per denom of the trader in the given epoch
+func (k Keeper) PeekTraderSwapVolume(ctx sdk.Context, epoch int64, trader sdk.AccAddress) (res sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTraderSwapVolumeKey(epoch, trader))
+	if bz == nil {
+		return sdk.Coins{}
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &res)
+	return
+}
+
+// PruneSwapVolumes deletes the swap volumes of the epochs older than VolumeRetention epochs
+func (k Keeper) PruneSwapVolumes(ctx sdk.Context) {
+	cutoff := core.GetEpoch(ctx) - k.VolumeRetention(ctx) + 1
+	if cutoff <= 0 {
+		return
+	}
+
+	k.pruneEpochKeys(ctx, types.SwapVolumeKey, cutoff)
+	k.pruneEpochKeys(ctx, types.TraderSwapVolumeKey, cutoff)
+}
+
+// pruneEpochKeys deletes the keys under the prefix whose big endian epoch is below the cutoff
+func (k Keeper) pruneEpochKeys(ctx sdk.Context, prefix []byte, cutoff int64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+
+	var staleKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		epoch := int64(binary.BigEndian.Uint64(key[len(prefix) : len(prefix)+8]))
+		if epoch >= cutoff {
+			break
+		}
+
+		staleKeys = append(staleKeys, key)
+	}
+	iter.Close()
+
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+}
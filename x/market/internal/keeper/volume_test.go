@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+)
+
+func TestRecordSwapVolume(t *testing.T) {
+	input := CreateTestInput(t)
+
+	offerCoin := sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(100))
+	swapCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(50))
+	input.MarketKeeper.RecordSwapVolume(input.Ctx, Addrs[0], offerCoin, swapCoin)
+	input.MarketKeeper.RecordSwapVolume(input.Ctx, Addrs[1], offerCoin, swapCoin)
+
+	volume := sdk.NewCoins(offerCoin, swapCoin)
+	require.Equal(t, volume.Add(volume), input.MarketKeeper.PeekSwapVolume(input.Ctx, 0))
+	require.Equal(t, sdk.Coins{}, input.MarketKeeper.PeekSwapVolume(input.Ctx, 1))
+
+	// trader volumes are not recorded by default
+	require.Equal(t, sdk.Coins{}, input.MarketKeeper.PeekTraderSwapVolume(input.Ctx, 0, Addrs[0]))
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.TraderVolume = true
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch)
+	input.MarketKeeper.RecordSwapVolume(input.Ctx, Addrs[0], offerCoin, swapCoin)
+	input.MarketKeeper.RecordSwapVolume(input.Ctx, Addrs[0], offerCoin, swapCoin)
+	input.MarketKeeper.RecordSwapVolume(input.Ctx, Addrs[1], offerCoin, swapCoin)
+
+	require.Equal(t, volume.Add(volume).Add(volume), input.MarketKeeper.PeekSwapVolume(input.Ctx, 1))
+	require.Equal(t, volume.Add(volume), input.MarketKeeper.PeekTraderSwapVolume(input.Ctx, 1, Addrs[0]))
+	require.Equal(t, volume, input.MarketKeeper.PeekTraderSwapVolume(input.Ctx, 1, Addrs[1]))
+	require.Equal(t, sdk.Coins{}, input.MarketKeeper.PeekTraderSwapVolume(input.Ctx, 1, Addrs[2]))
+}
+
+func TestPruneSwapVolumes(t *testing.T) {
+	input := CreateTestInput(t)
+
+	params := input.MarketKeeper.GetParams(input.Ctx)
+	params.VolumeRetention = 2
+	params.TraderVolume = true
+	input.MarketKeeper.SetParams(input.Ctx, params)
+
+	offerCoin := sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(100))
+	swapCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(50))
+	volume := sdk.NewCoins(offerCoin, swapCoin)
+	for epoch := int64(0); epoch < 4; epoch++ {
+		input.Ctx = input.Ctx.WithBlockHeight(epoch * core.BlocksPerEpoch)
+		input.MarketKeeper.RecordSwapVolume(input.Ctx, Addrs[0], offerCoin, swapCoin)
+	}
+
+	// only the current and the previous epoch are retained
+	input.MarketKeeper.PruneSwapVolumes(input.Ctx)
+	for epoch := int64(0); epoch < 2; epoch++ {
+		require.Equal(t, sdk.Coins{}, input.MarketKeeper.PeekSwapVolume(input.Ctx, epoch))
+		require.Equal(t, sdk.Coins{}, input.MarketKeeper.PeekTraderSwapVolume(input.Ctx, epoch, Addrs[0]))
+	}
+	for epoch := int64(2); epoch < 4; epoch++ {
+		require.Equal(t, volume, input.MarketKeeper.PeekSwapVolume(input.Ctx, epoch))
+		require.Equal(t, volume, input.MarketKeeper.PeekTraderSwapVolume(input.Ctx, epoch, Addrs[0]))
+	}
+}
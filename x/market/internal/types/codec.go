@@ -2,6 +2,8 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/terra-project/core/x/gov"
 )
 
 // Internal Module Codec
@@ -10,8 +12,19 @@ var ModuleCdc = codec.New()
 // RegisterCodec concretes types on codec codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSwap{}, "market/MsgSwap", nil)
+	cdc.RegisterConcrete(MsgSwapSend{}, "market/MsgSwapSend", nil)
+	cdc.RegisterConcrete(MsgRouteSwap{}, "market/MsgRouteSwap", nil)
+	cdc.RegisterConcrete(MsgPlaceLimitOrder{}, "market/MsgPlaceLimitOrder", nil)
+	cdc.RegisterConcrete(MsgCancelLimitOrder{}, "market/MsgCancelLimitOrder", nil)
+	cdc.RegisterConcrete(MsgCreateSwapSchedule{}, "market/MsgCreateSwapSchedule", nil)
+	cdc.RegisterConcrete(MsgCancelSwapSchedule{}, "market/MsgCancelSwapSchedule", nil)
+	cdc.RegisterConcrete(CircuitBreakerProposal{}, "market/CircuitBreakerProposal", nil)
+	cdc.RegisterConcrete(DenomParamsUpdateProposal{}, "market/DenomParamsUpdateProposal", nil)
 }
 
 func init() {
 	RegisterCodec(ModuleCdc)
+
+	gov.RegisterProposalTypeCodec(CircuitBreakerProposal{}, "market/CircuitBreakerProposal")
+	gov.RegisterProposalTypeCodec(DenomParamsUpdateProposal{}, "market/DenomParamsUpdateProposal")
 }
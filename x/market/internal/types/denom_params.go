@@ -0,0 +1,119 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+)
+
+// DenomParams overrides the Luna swap params for swaps whose Terra side is the denom
+type DenomParams struct {
+	Denom             string  `json:"denom" yaml:"denom"`
+	DailyLunaDeltaCap sdk.Dec `json:"daily_luna_delta_cap" yaml:"daily_luna_delta_cap"`
+	MinSwapSpread     sdk.Dec `json:"min_swap_spread" yaml:"min_swap_spread"`
+	MaxSwapSpread     sdk.Dec `json:"max_swap_spread" yaml:"max_swap_spread"`
+}
+
+// NewDenomParams creates a DenomParams instance
+func NewDenomParams(denom string, dailyLunaDeltaCap, minSwapSpread, maxSwapSpread sdk.Dec) DenomParams {
+	return DenomParams{
+		Denom:             denom,
+		DailyLunaDeltaCap: dailyLunaDeltaCap,
+		MinSwapSpread:     minSwapSpread,
+		MaxSwapSpread:     maxSwapSpread,
+	}
+}
+
+// String implements fmt.Stringer interface
+func (dp DenomParams) String() string {
+	return fmt.Sprintf("%s: {DailyLunaDeltaCap: %s, MinSwapSpread: %s, MaxSwapSpread: %s}",
+		dp.Denom, dp.DailyLunaDeltaCap, dp.MinSwapSpread, dp.MaxSwapSpread)
+}
+
+// Validate checks the overrides are within the same bounds as the global params
+func (dp DenomParams) Validate() error {
+	if len(dp.Denom) == 0 || dp.Denom == core.MicroLunaDenom {
+		return fmt.Errorf("denom params denom should be a Terra denom, is %q", dp.Denom)
+	}
+	if dp.DailyLunaDeltaCap.IsNil() || dp.DailyLunaDeltaCap.IsNegative() {
+		return fmt.Errorf("daily luna issuance change of %s should be non-negative, is %s", dp.Denom, dp.DailyLunaDeltaCap)
+	}
+	if dp.MinSwapSpread.IsNil() || dp.MinSwapSpread.IsNegative() || dp.MinSwapSpread.GT(sdk.OneDec()) {
+		return fmt.Errorf("minimum swap spread of %s should be within [0, 1], is %s", dp.Denom, dp.MinSwapSpread)
+	}
+	if dp.MaxSwapSpread.IsNil() || dp.MaxSwapSpread.LT(dp.MinSwapSpread) || dp.MaxSwapSpread.GT(sdk.OneDec()) {
+		return fmt.Errorf("maximum swap spread of %s should be larger or equal to the minimum, is %s", dp.Denom, dp.MaxSwapSpread)
+	}
+
+	return nil
+}
+
+// DenomParamsList is a collection of per-denom param overrides
+type DenomParamsList []DenomParams
+
+// String implements fmt.Stringer interface
+func (dl DenomParamsList) String() string {
+	out := make([]string, len(dl))
+	for i, dp := range dl {
+		out[i] = dp.String()
+	}
+	return strings.Join(out, ", ")
+}
+
+// ParamsOf returns the overrides of the denom, and whether the list has it
+func (dl DenomParamsList) ParamsOf(denom string) (DenomParams, bool) {
+	for _, dp := range dl {
+		if dp.Denom == denom {
+			return dp, true
+		}
+	}
+
+	return DenomParams{}, false
+}
+
+// Upsert returns a copy of the list with the overrides of the denom replaced, or appended if not listed
+func (dl DenomParamsList) Upsert(denomParams DenomParams) DenomParamsList {
+	res := make(DenomParamsList, len(dl), len(dl)+1)
+	copy(res, dl)
+	for i, dp := range res {
+		if dp.Denom == denomParams.Denom {
+			res[i] = denomParams
+			return res
+		}
+	}
+
+	return append(res, denomParams)
+}
+
+// Remove returns a copy of the list without the overrides of the denom
+func (dl DenomParamsList) Remove(denom string) DenomParamsList {
+	res := make(DenomParamsList, 0, len(dl))
+	for _, dp := range dl {
+		if dp.Denom != denom {
+			res = append(res, dp)
+		}
+	}
+
+	return res
+}
+
+// Validate checks every entry is valid, and no denom is listed twice
+func (dl DenomParamsList) Validate() error {
+	denoms := make(map[string]bool)
+	for _, dp := range dl {
+		if err := dp.Validate(); err != nil {
+			return err
+		}
+
+		if denoms[dp.Denom] {
+			return fmt.Errorf("denom params of %s are listed twice", dp.Denom)
+		}
+
+		denoms[dp.Denom] = true
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,6 +16,18 @@ const (
 	CodeNoEffectivePrice codeType = 2
 	CodeRecursiveSwap    codeType = 3
 	CodeExceedsSwapLimit codeType = 4
+	CodeSlippage         codeType = 5
+	CodeEmptySwapRoute   codeType = 6
+	CodeNoLimitOrder     codeType = 7
+	CodeInvalidExpiry    codeType = 8
+	CodeInvalidEpoch     codeType = 9
+	CodeCircuitBreaker   codeType = 10
+	CodeNoSwapSchedule   codeType = 11
+	CodeInvalidSchedule  codeType = 12
+	CodePriceUnsettled   codeType = 13
+	CodeStalePrice       codeType = 14
+	CodeInvalidLimit     codeType = 15
+	CodeSwapRouteTooLong codeType = 16
 )
 
 // ----------------------------------------
@@ -24,6 +38,16 @@ func ErrNoEffectivePrice(codespace sdk.CodespaceType, denom string) sdk.Error {
 	return sdk.NewError(codespace, CodeNoEffectivePrice, "No price registered with the oracle for asset: "+denom)
 }
 
+// ErrPriceUnsettled called when the oracle price of the asset moved beyond the price band and has not settled yet
+func ErrPriceUnsettled(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodePriceUnsettled, "Oracle price has not settled for asset: "+denom)
+}
+
+// ErrStalePrice called when the oracle price of the asset was tallied longer ago than MaxOraclePriceAge vote periods
+func ErrStalePrice(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeStalePrice, "Oracle price is stale for asset: "+denom)
+}
+
 // ErrInsufficientSwapCoins called when not enough coins are being requested for a swap
 func ErrInsufficientSwapCoins(codespace sdk.CodespaceType, rval sdk.Int) sdk.Error {
 	return sdk.NewError(codespace, CodeInsufficientSwap, "Not enough coins for a swap: "+rval.String())
@@ -38,3 +62,65 @@ func ErrRecursiveSwap(codespace sdk.CodespaceType, denom string) sdk.Error {
 func ErrExceedsDailySwapLimit(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeExceedsSwapLimit, "Exceeded the daily swap limit for Luna")
 }
+
+// ErrSlippage called when the swapped coin is less than the minimum amount the trader asked for
+func ErrSlippage(codespace sdk.CodespaceType, swapCoin sdk.Coin, minAskAmount sdk.Int) sdk.Error {
+	return sdk.NewError(codespace, CodeSlippage, fmt.Sprintf("Swapped coin %s is less than the minimum ask amount %s", swapCoin, minAskAmount))
+}
+
+// ErrEmptySwapRoute called when a route swap has no ask denoms
+func ErrEmptySwapRoute(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeEmptySwapRoute, "Swap route should have at least one ask denom")
+}
+
+// ErrSwapRouteTooLong called when a route swap has more ask denoms than MaxSwapRouteHops
+func ErrSwapRouteTooLong(codespace sdk.CodespaceType, hops int) sdk.Error {
+	return sdk.NewError(codespace, CodeSwapRouteTooLong, fmt.Sprintf("Swap route has %d ask denoms, more than the maximum of %d", hops, MaxSwapRouteHops))
+}
+
+// ErrNoLimitOrder called when no limit order exists for the given ID
+func ErrNoLimitOrder(codespace sdk.CodespaceType, orderID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeNoLimitOrder, fmt.Sprintf("No limit order exists with ID: %d", orderID))
+}
+
+// ErrInvalidExpiryHeight called when a limit order expires before it can rest in the market
+func ErrInvalidExpiryHeight(codespace sdk.CodespaceType, expiryHeight int64, blockHeight int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidExpiry, fmt.Sprintf("Expiry height %d should be greater than the current block height %d", expiryHeight, blockHeight))
+}
+
+// ErrInvalidEpoch called when the query epoch is not within the range of past epochs
+func ErrInvalidEpoch(codespace sdk.CodespaceType, curEpoch, epoch int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEpoch, fmt.Sprintf("The query epoch should be between [0, %d] but given %d", curEpoch, epoch))
+}
+
+// ErrCircuitBreakerTripped called when a Luna swap would move the Luna supply beyond the hard limit over the rolling window
+func ErrCircuitBreakerTripped(codespace sdk.CodespaceType, lunaDelta sdk.Dec, limit sdk.Dec) sdk.Error {
+	return sdk.NewError(codespace, CodeCircuitBreaker, fmt.Sprintf("Luna swaps are halted; Luna supply change %s would exceed the hard limit %s", lunaDelta, limit))
+}
+
+// ErrCircuitBreakerHalted called when a Luna swap is submitted while governance has halted Luna swaps
+func ErrCircuitBreakerHalted(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeCircuitBreaker, "Luna swaps are halted by governance")
+}
+
+// ErrInvalidLimitPrice called when a limit order asks for more than the limit price or ask amount bounds
+func ErrInvalidLimitPrice(codespace sdk.CodespaceType, limitPrice sdk.Dec, offerAmount sdk.Int) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidLimit, fmt.Sprintf("Limit price %s for offer amount %s exceeds the limit price %s or ask amount %s bounds",
+		limitPrice, offerAmount, MaxLimitPrice, MaxLimitOrderAskAmount))
+}
+
+// ErrExceedsSwapScheduleEscrow called when the offer coins of all the executions of a swap schedule exceed the escrow bound
+func ErrExceedsSwapScheduleEscrow(codespace sdk.CodespaceType, offerAmount sdk.Int, executions int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSchedule, fmt.Sprintf("Swap schedule of %d executions of %s exceeds the escrow bound %s",
+		executions, offerAmount, MaxSwapScheduleEscrow))
+}
+
+// ErrNoSwapSchedule called when no swap schedule exists for the given ID
+func ErrNoSwapSchedule(codespace sdk.CodespaceType, scheduleID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeNoSwapSchedule, fmt.Sprintf("No swap schedule exists with ID: %d", scheduleID))
+}
+
+// ErrInvalidSwapSchedule called when a swap schedule has no positive interval or number of executions
+func ErrInvalidSwapSchedule(codespace sdk.CodespaceType, interval int64, executions int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSchedule, fmt.Sprintf("Swap schedule interval %d and executions %d should be positive", interval, executions))
+}
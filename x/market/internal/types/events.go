@@ -3,14 +3,35 @@ package types
 
 // Market module event types
 const (
-	EventSwap                = "swap"
-	EventDaliyIssuanceUpdate = "daliy_issuance_update"
+	EventSwap                 = "swap"
+	EventIssuanceUpdate       = "issuance_update"
+	EventPlaceLimitOrder      = "place_limit_order"
+	EventCancelLimitOrder     = "cancel_limit_order"
+	EventExecuteLimitOrder    = "execute_limit_order"
+	EventExpireLimitOrder     = "expire_limit_order"
+	EventSwapFee              = "swap_fee"
+	EventCircuitBreaker       = "circuit_breaker"
+	EventCreateSwapSchedule   = "create_swap_schedule"
+	EventCancelSwapSchedule   = "cancel_swap_schedule"
+	EventExecuteSwapSchedule  = "execute_swap_schedule"
+	EventSkipSwapSchedule     = "skip_swap_schedule"
+	EventCompleteSwapSchedule = "complete_swap_schedule"
 
-	AttributeKeyOffer    = "offer"
-	AttributeKeyTrader   = "trader"
-	AttributeKeySwapCoin = "swap_coin"
-	AttributeKeySwapFee  = "swap_fee"
-	AttributeKeyIssuance = "issuance"
+	AttributeKeyOffer      = "offer"
+	AttributeKeyTrader     = "trader"
+	AttributeKeyRecipient  = "recipient"
+	AttributeKeySwapCoin   = "swap_coin"
+	AttributeKeySwapFee    = "swap_fee"
+	AttributeKeyIssuance   = "issuance"
+	AttributeKeyHour       = "hour"
+	AttributeKeyHalted     = "halted"
+	AttributeKeyOrderID    = "order_id"
+	AttributeKeyOracleFee  = "oracle_fee"
+	AttributeKeyCommunity  = "community_fee"
+	AttributeKeyScheduleID = "schedule_id"
+	AttributeKeyRemaining  = "remaining"
+	AttributeKeyNextHeight = "next_height"
+	AttributeKeyReason     = "reason"
 
 	AttributeValueCategory = ModuleName
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 )
 
@@ -20,4 +21,19 @@ type SupplyKeeper interface {
 // expected oracle keeper
 type OracleKeeper interface {
 	GetLunaPrice(ctx sdk.Context, denom string) (price sdk.Dec, err sdk.Error)
+	GetFreshLunaPrice(ctx sdk.Context, denom string, maxAge int64) (price sdk.Dec, err sdk.Error)
+	IsPriceUnsettled(ctx sdk.Context, denom string) bool
+	IsWhitelisted(ctx sdk.Context, denom string) bool
+}
+
+// expected keeper for distribution module
+type DistributionKeeper interface {
+	GetFeePool(ctx sdk.Context) (feePool distrtypes.FeePool)
+	SetFeePool(ctx sdk.Context, feePool distrtypes.FeePool)
+}
+
+// MarketHooks event hooks for swaps executed by the market module
+type MarketHooks interface {
+	BeforeSwap(ctx sdk.Context, trader sdk.AccAddress, offerCoin sdk.Coin, askDenom string) sdk.Error          // Must be called before a swap is applied; an error vetoes the swap
+	AfterSwap(ctx sdk.Context, trader sdk.AccAddress, offerCoin sdk.Coin, swapCoin sdk.Coin, swapFee sdk.Coin) // Must be called after a swap is settled
 }
@@ -1,30 +1,112 @@
 package types
 
-import "bytes"
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // GenesisState - all market state that must be provided at genesis
 type GenesisState struct {
-	Params Params `json:"params" yaml:"params"` // market params
+	Params              Params          `json:"params" yaml:"params"`                               // market params
+	TerraPoolDelta      sdk.Dec         `json:"terra_pool_delta" yaml:"terra_pool_delta"`           // terra pool delta from the base pool
+	LimitOrders         []LimitOrder    `json:"limit_orders" yaml:"limit_orders"`                   // resting limit orders
+	LimitOrderCursor    uint64          `json:"limit_order_cursor" yaml:"limit_order_cursor"`       // ID of the limit order to be tried first by the next matching
+	IssuanceBuckets     IssuanceBuckets `json:"issuance_buckets" yaml:"issuance_buckets"`           // hourly issuance of the rolling window
+	SwapHalted          bool            `json:"swap_halted" yaml:"swap_halted"`                     // whether Luna swaps are halted by governance
+	SwapSchedules       []SwapSchedule  `json:"swap_schedules" yaml:"swap_schedules"`               // active swap schedules
+	CircuitBreakerReset IssuanceBucket  `json:"circuit_breaker_reset" yaml:"circuit_breaker_reset"` // issuance at the last governance reset of the circuit breaker; empty if never reset
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params) GenesisState {
+func NewGenesisState(params Params, terraPoolDelta sdk.Dec, limitOrders []LimitOrder, limitOrderCursor uint64,
+	issuanceBuckets IssuanceBuckets, swapHalted bool, swapSchedules []SwapSchedule, circuitBreakerReset IssuanceBucket) GenesisState {
 	return GenesisState{
-		Params: params,
+		Params:              params,
+		TerraPoolDelta:      terraPoolDelta,
+		LimitOrders:         limitOrders,
+		LimitOrderCursor:    limitOrderCursor,
+		IssuanceBuckets:     issuanceBuckets,
+		SwapHalted:          swapHalted,
+		SwapSchedules:       swapSchedules,
+		CircuitBreakerReset: circuitBreakerReset,
 	}
 }
 
 // get raw genesis raw message for testing
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params: DefaultParams(),
+		Params:              DefaultParams(),
+		TerraPoolDelta:      sdk.ZeroDec(),
+		LimitOrders:         []LimitOrder{},
+		LimitOrderCursor:    0,
+		IssuanceBuckets:     IssuanceBuckets{},
+		SwapHalted:          false,
+		SwapSchedules:       []SwapSchedule{},
+		CircuitBreakerReset: IssuanceBucket{},
 	}
 }
 
 // ValidateGenesis validates the provided oracle genesis state to ensure the
 // expected invariants holds. (i.e. params in correct bounds, no duplicate validators)
 func ValidateGenesis(data GenesisState) error {
-	return data.Params.Validate()
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if !data.Params.BasePool.Add(data.TerraPoolDelta).IsPositive() {
+		return fmt.Errorf("terra pool delta %s exhausts the base pool %s", data.TerraPoolDelta, data.Params.BasePool)
+	}
+
+	orderIDs := make(map[uint64]bool)
+	for _, order := range data.LimitOrders {
+		if err := order.Validate(); err != nil {
+			return err
+		}
+
+		if orderIDs[order.OrderID] {
+			return fmt.Errorf("duplicate limit order ID %d", order.OrderID)
+		}
+
+		orderIDs[order.OrderID] = true
+	}
+
+	scheduleIDs := make(map[uint64]bool)
+	for _, schedule := range data.SwapSchedules {
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+
+		if scheduleIDs[schedule.ScheduleID] {
+			return fmt.Errorf("duplicate swap schedule ID %d", schedule.ScheduleID)
+		}
+
+		scheduleIDs[schedule.ScheduleID] = true
+	}
+
+	hours := make(map[int64]bool)
+	for _, bucket := range data.IssuanceBuckets {
+		if bucket.Hour < 0 {
+			return fmt.Errorf("issuance bucket hour should be non-negative, is %d", bucket.Hour)
+		}
+
+		if !bucket.Issuance.IsValid() {
+			return fmt.Errorf("invalid issuance %s of the bucket at hour %d", bucket.Issuance, bucket.Hour)
+		}
+
+		if hours[bucket.Hour] {
+			return fmt.Errorf("duplicate issuance bucket hour %d", bucket.Hour)
+		}
+
+		hours[bucket.Hour] = true
+	}
+
+	if reset := data.CircuitBreakerReset; reset.Hour < 0 || !reset.Issuance.IsValid() {
+		return fmt.Errorf("invalid circuit breaker reset of issuance %s at hour %d", reset.Issuance, reset.Hour)
+	}
+
+	return nil
 }
 
 // Checks whether 2 GenesisState structs are equivalent.
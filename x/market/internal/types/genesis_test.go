@@ -6,6 +6,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+
+	core "github.com/terra-project/core/types"
 )
 
 func TestGenesisValidation(t *testing.T) {
@@ -22,6 +25,209 @@ func TestGenesisValidation(t *testing.T) {
 	genState.Params.MaxSwapSpread = sdk.ZeroDec()
 	genState.Params.MinSwapSpread = sdk.NewDec(-1)
 	require.Error(t, ValidateGenesis(genState))
+
+	genState.Params.MinSwapSpread = sdk.ZeroDec()
+	genState.Params.BasePool = sdk.ZeroDec()
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.Params.BasePool = sdk.NewDec(1000)
+	genState.Params.PoolRecoveryPeriod = 0
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.Params.PoolRecoveryPeriod = 1
+	genState.Params.SpreadModel = "exponential"
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.Params.SpreadModel = SpreadModelLinear
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.Params.TobinTax = sdk.NewDec(2)
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.Params.TobinTax = sdk.NewDecWithPrec(25, 4)
+	genState.Params.TobinTaxOverrides = TobinTaxList{NewTobinTax(core.MicroKRWDenom, sdk.NewDec(-1))}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.Params.TobinTaxOverrides = TobinTaxList{
+		NewTobinTax(core.MicroKRWDenom, sdk.NewDecWithPrec(1, 2)),
+		NewTobinTax(core.MicroKRWDenom, sdk.NewDecWithPrec(2, 2)),
+	}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.Params.TobinTaxOverrides = TobinTaxList{NewTobinTax(core.MicroKRWDenom, sdk.NewDecWithPrec(1, 2))}
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.Params.OracleFeeShare = sdk.NewDecWithPrec(11, 1)
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.Params.OracleFeeShare = sdk.ZeroDec()
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.Params.LunaDeltaHardLimit = sdk.ZeroDec()
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.Params.LunaDeltaHardLimit = sdk.NewDecWithPrec(11, 1)
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.Params.LunaDeltaHardLimit = sdk.NewDecWithPrec(1, 1)
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.Params.VolumeRetention = 0
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.Params.VolumeRetention = 1
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.Params.MaxOraclePriceAge = 0
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.Params.MaxOraclePriceAge = 2
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.Params.MaxLimitOrderMatches = 0
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.Params.MaxLimitOrderMatches = 50
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.Params.MaxScheduleExecutions = 0
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.Params.MaxScheduleExecutions = 50
+	require.NoError(t, ValidateGenesis(genState))
+
+	denomParams := NewDenomParams(core.MicroGBPDenom, sdk.NewDecWithPrec(1, 3), sdk.NewDecWithPrec(5, 2), sdk.OneDec())
+	genState.Params.DenomParams = DenomParamsList{denomParams}
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.Params.DenomParams = DenomParamsList{denomParams, denomParams}
+	require.Error(t, ValidateGenesis(genState))
+
+	invalidDenomParams := denomParams
+	invalidDenomParams.Denom = core.MicroLunaDenom
+	genState.Params.DenomParams = DenomParamsList{invalidDenomParams}
+	require.Error(t, ValidateGenesis(genState))
+
+	invalidDenomParams = denomParams
+	invalidDenomParams.DailyLunaDeltaCap = sdk.NewDec(-1)
+	genState.Params.DenomParams = DenomParamsList{invalidDenomParams}
+	require.Error(t, ValidateGenesis(genState))
+
+	invalidDenomParams = denomParams
+	invalidDenomParams.MinSwapSpread = sdk.NewDecWithPrec(11, 1)
+	genState.Params.DenomParams = DenomParamsList{invalidDenomParams}
+	require.Error(t, ValidateGenesis(genState))
+
+	invalidDenomParams = denomParams
+	invalidDenomParams.MaxSwapSpread = sdk.NewDecWithPrec(1, 2)
+	genState.Params.DenomParams = DenomParamsList{invalidDenomParams}
+	require.Error(t, ValidateGenesis(genState))
+
+	invalidDenomParams = denomParams
+	invalidDenomParams.MaxSwapSpread = sdk.Dec{}
+	genState.Params.DenomParams = DenomParamsList{invalidDenomParams}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.Params.DenomParams = DenomParamsList{}
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.TerraPoolDelta = sdk.NewDec(-1000)
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.TerraPoolDelta = sdk.NewDec(-999)
+	require.NoError(t, ValidateGenesis(genState))
+}
+
+func TestGenesisLimitOrderValidation(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	order := NewLimitOrder(1, addrs[0], sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt()), core.MicroSDRDenom, sdk.OneDec(), 10)
+
+	genState := DefaultGenesisState()
+	genState.LimitOrders = []LimitOrder{order}
+	require.NoError(t, ValidateGenesis(genState))
+
+	// duplicate order ID
+	genState.LimitOrders = []LimitOrder{order, order}
+	require.Error(t, ValidateGenesis(genState))
+
+	invalidOrder := order
+	invalidOrder.LimitPrice = sdk.ZeroDec()
+	genState.LimitOrders = []LimitOrder{invalidOrder}
+	require.Error(t, ValidateGenesis(genState))
+
+	invalidOrder = order
+	invalidOrder.LimitPrice = MaxLimitPrice.Add(sdk.OneDec())
+	genState.LimitOrders = []LimitOrder{invalidOrder}
+	require.Error(t, ValidateGenesis(genState))
+
+	invalidOrder = order
+	invalidOrder.AskDenom = core.MicroLunaDenom
+	genState.LimitOrders = []LimitOrder{invalidOrder}
+	require.Error(t, ValidateGenesis(genState))
+
+	invalidOrder = order
+	invalidOrder.Trader = sdk.AccAddress{}
+	genState.LimitOrders = []LimitOrder{invalidOrder}
+	require.Error(t, ValidateGenesis(genState))
+}
+
+func TestGenesisSwapScheduleValidation(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	schedule := NewSwapSchedule(1, addrs[0], sdk.NewCoin(core.MicroSDRDenom, sdk.OneInt()), core.MicroKRWDenom, 10, 5, 10)
+
+	genState := DefaultGenesisState()
+	genState.SwapSchedules = []SwapSchedule{schedule}
+	require.NoError(t, ValidateGenesis(genState))
+
+	// duplicate schedule ID
+	genState.SwapSchedules = []SwapSchedule{schedule, schedule}
+	require.Error(t, ValidateGenesis(genState))
+
+	invalidSchedule := schedule
+	invalidSchedule.Interval = 0
+	genState.SwapSchedules = []SwapSchedule{invalidSchedule}
+	require.Error(t, ValidateGenesis(genState))
+
+	invalidSchedule = schedule
+	invalidSchedule.Remaining = 0
+	genState.SwapSchedules = []SwapSchedule{invalidSchedule}
+	require.Error(t, ValidateGenesis(genState))
+
+	invalidSchedule = schedule
+	invalidSchedule.AskDenom = core.MicroSDRDenom
+	genState.SwapSchedules = []SwapSchedule{invalidSchedule}
+	require.Error(t, ValidateGenesis(genState))
+}
+
+func TestGenesisIssuanceBucketValidation(t *testing.T) {
+	bucket := NewIssuanceBucket(1, sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt())))
+
+	genState := DefaultGenesisState()
+	genState.IssuanceBuckets = IssuanceBuckets{bucket}
+	require.NoError(t, ValidateGenesis(genState))
+
+	// duplicate hour
+	genState.IssuanceBuckets = IssuanceBuckets{bucket, bucket}
+	require.Error(t, ValidateGenesis(genState))
+
+	invalidBucket := bucket
+	invalidBucket.Hour = -1
+	genState.IssuanceBuckets = IssuanceBuckets{invalidBucket}
+	require.Error(t, ValidateGenesis(genState))
+
+	invalidBucket = bucket
+	invalidBucket.Issuance = sdk.Coins{sdk.Coin{Denom: core.MicroLunaDenom, Amount: sdk.NewInt(-1)}}
+	genState.IssuanceBuckets = IssuanceBuckets{invalidBucket}
+	require.Error(t, ValidateGenesis(genState))
+
+	genState.IssuanceBuckets = IssuanceBuckets{bucket}
+	genState.CircuitBreakerReset = bucket
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.CircuitBreakerReset = invalidBucket
+	require.Error(t, ValidateGenesis(genState))
 }
 
 func TestGenesisEqual(t *testing.T) {
@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MultiMarketHooks combines multiple market hooks, all hook functions are run in array sequence
+type MultiMarketHooks []MarketHooks
+
+// NewMultiMarketHooks creates a MultiMarketHooks instance
+func NewMultiMarketHooks(hooks ...MarketHooks) MultiMarketHooks {
+	return hooks
+}
+
+// BeforeSwap runs the BeforeSwap hooks in sequence, and stops at the first veto
+func (h MultiMarketHooks) BeforeSwap(ctx sdk.Context, trader sdk.AccAddress, offerCoin sdk.Coin, askDenom string) sdk.Error {
+	for i := range h {
+		if err := h[i].BeforeSwap(ctx, trader, offerCoin, askDenom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AfterSwap runs the AfterSwap hooks in sequence
+func (h MultiMarketHooks) AfterSwap(ctx sdk.Context, trader sdk.AccAddress, offerCoin sdk.Coin, swapCoin sdk.Coin, swapFee sdk.Coin) {
+	for i := range h {
+		h[i].AfterSwap(ctx, trader, offerCoin, swapCoin, swapFee)
+	}
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+)
+
+// IssuanceWindowHours is the number of hourly issuance buckets the Luna supply change is computed over
+const IssuanceWindowHours = core.BlocksPerDay / core.BlocksPerHour
+
+// IssuanceBucket is the total issuance recorded at the last block of an hour
+type IssuanceBucket struct {
+	Hour     int64     `json:"hour" yaml:"hour"`         // Hour since genesis, BlockHeight / BlocksPerHour
+	Issuance sdk.Coins `json:"issuance" yaml:"issuance"` // Total issuance at the end of the hour
+}
+
+// NewIssuanceBucket creates an IssuanceBucket instance
+func NewIssuanceBucket(hour int64, issuance sdk.Coins) IssuanceBucket {
+	return IssuanceBucket{
+		Hour:     hour,
+		Issuance: issuance,
+	}
+}
+
+// String implements fmt.Stringer interface
+func (bucket IssuanceBucket) String() string {
+	return fmt.Sprintf(`IssuanceBucket
+	Hour:     %d
+	Issuance: %s`,
+		bucket.Hour, bucket.Issuance)
+}
+
+// IssuanceBuckets is a collection of IssuanceBucket
+type IssuanceBuckets []IssuanceBucket
+
+// String implements fmt.Stringer interface
+func (buckets IssuanceBuckets) String() (out string) {
+	for _, bucket := range buckets {
+		out += bucket.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// GetIssuanceHour returns the hour since genesis the current block belongs to
+func GetIssuanceHour(ctx sdk.Context) int64 {
+	return ctx.BlockHeight() / core.BlocksPerHour
+}
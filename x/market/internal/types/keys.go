@@ -1,5 +1,11 @@
 package types
 
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName is the name of the market module
 	ModuleName = "market"
@@ -12,13 +18,128 @@ const (
 
 	// QuerierRoute is the query router key for the market module
 	QuerierRoute = ModuleName
+
+	// ScheduleEscrowName is the name of the module account escrowing the offer coins of swap schedules
+	ScheduleEscrowName = "swap_schedule_escrow"
 )
 
 // Keys for market store
 // Items are stored with the following key: values
 //
 // - 0x01: sdk.Int
+//
+// - 0x02: sdk.Dec
+//
+// - 0x03<orderID_Bytes>: LimitOrder
+//
+// - 0x04: uint64
+//
+// - 0x05<epoch_Bytes>: sdk.Coins
+//
+// - 0x06<hour_Bytes>: IssuanceBucket
+//
+// - 0x07: bool
+//
+// - 0x08<epoch_Bytes>: sdk.Coins
+//
+// - 0x09<epoch_Bytes><trader_Bytes>: sdk.Coins
+//
+// - 0x0A<scheduleID_Bytes>: SwapSchedule
+//
+// - 0x0B: uint64
+//
+// - 0x0C: uint64
+//
+// - 0x0D<expiryHeight_Bytes><orderID_Bytes>: nil
+//
+// - 0x0E: IssuanceBucket
+//
+// - 0x0F<nextHeight_Bytes><scheduleID_Bytes>: nil
 var (
 	//Keys for store prefixed
-	PrevDayIssuanceKey = []byte{0x01} // key for prev day issuance
+	PrevDayIssuanceKey     = []byte{0x01} // key for prev day issuance; legacy, migrated to the issuance buckets
+	TerraPoolDeltaKey      = []byte{0x02} // key for terra pool delta from the base pool
+	LimitOrderKey          = []byte{0x03} // prefix for each key to a limit order
+	NextLimitOrderIDKey    = []byte{0x04} // key for the ID of the next limit order
+	SwapFeeProceedsKey     = []byte{0x05} // prefix for each key to a swap-fee-proceeds
+	IssuanceBucketKey      = []byte{0x06} // prefix for each key to an hourly issuance bucket
+	SwapHaltedKey          = []byte{0x07} // key for whether governance has halted Luna swaps
+	SwapVolumeKey          = []byte{0x08} // prefix for each key to the swap volume of an epoch
+	TraderSwapVolumeKey    = []byte{0x09} // prefix for each key to the swap volume of a trader in an epoch
+	SwapScheduleKey        = []byte{0x0A} // prefix for each key to a swap schedule
+	NextSwapScheduleIDKey  = []byte{0x0B} // key for the ID of the next swap schedule
+	LimitOrderCursorKey    = []byte{0x0C} // key for the ID of the limit order to be tried first at the next block
+	LimitOrderExpiryKey    = []byte{0x0D} // prefix for each key to a limit order, indexed by expiry height
+	CircuitBreakerResetKey = []byte{0x0E} // key for the issuance at the last governance reset of the circuit breaker
+	SwapScheduleQueueKey   = []byte{0x0F} // prefix for each key to a swap schedule, indexed by next execution height
 )
+
+// GetLimitOrderKey - stored by *orderID*; big endian so that orders iterate in placement order
+func GetLimitOrderKey(orderID uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, orderID)
+	return append(LimitOrderKey, b...)
+}
+
+// GetLimitOrderExpiryPrefixKey - stored by *expiryHeight*; big endian so that orders iterate from the earliest expiry
+func GetLimitOrderExpiryPrefixKey(expiryHeight int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(expiryHeight))
+	return append(LimitOrderExpiryKey, b...)
+}
+
+// GetLimitOrderExpiryKey - stored by *expiryHeight* and *orderID*
+func GetLimitOrderExpiryKey(expiryHeight int64, orderID uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, orderID)
+	return append(GetLimitOrderExpiryPrefixKey(expiryHeight), b...)
+}
+
+// GetSwapFeeProceedsKey - stored by *epoch*
+func GetSwapFeeProceedsKey(epoch int64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(epoch))
+	return append(SwapFeeProceedsKey, b...)
+}
+
+// GetIssuanceBucketKey - stored by *hour*; big endian so that buckets iterate from the oldest
+func GetIssuanceBucketKey(hour int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(hour))
+	return append(IssuanceBucketKey, b...)
+}
+
+// GetSwapVolumeKey - stored by *epoch*; big endian so that volumes iterate from the oldest epoch
+func GetSwapVolumeKey(epoch int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(epoch))
+	return append(SwapVolumeKey, b...)
+}
+
+// GetTraderSwapVolumeKey - stored by *epoch* and *trader*; big endian so that volumes iterate from the oldest epoch
+func GetTraderSwapVolumeKey(epoch int64, trader sdk.AccAddress) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(epoch))
+	return append(append(TraderSwapVolumeKey, b...), trader.Bytes()...)
+}
+
+// GetSwapScheduleKey - stored by *scheduleID*; big endian so that schedules iterate in creation order
+func GetSwapScheduleKey(scheduleID uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, scheduleID)
+	return append(SwapScheduleKey, b...)
+}
+
+// GetSwapScheduleQueuePrefixKey - stored by *nextHeight*; big endian so that schedules iterate in due order
+func GetSwapScheduleQueuePrefixKey(nextHeight int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(nextHeight))
+	return append(SwapScheduleQueueKey, b...)
+}
+
+// GetSwapScheduleQueueKey - stored by *nextHeight* and *scheduleID*
+func GetSwapScheduleQueueKey(nextHeight int64, scheduleID uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, scheduleID)
+	return append(GetSwapScheduleQueuePrefixKey(nextHeight), b...)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -9,6 +10,12 @@ import (
 // ensure Msg interface compliance at compile time
 var (
 	_ sdk.Msg = &MsgSwap{}
+	_ sdk.Msg = &MsgSwapSend{}
+	_ sdk.Msg = &MsgRouteSwap{}
+	_ sdk.Msg = &MsgPlaceLimitOrder{}
+	_ sdk.Msg = &MsgCancelLimitOrder{}
+	_ sdk.Msg = &MsgCreateSwapSchedule{}
+	_ sdk.Msg = &MsgCancelSwapSchedule{}
 )
 
 //--------------------------------------------------------
@@ -71,3 +78,431 @@ func (msg MsgSwap) String() string {
 	ask:       %s`,
 		msg.Trader, msg.OfferCoin, msg.AskDenom)
 }
+
+//--------------------------------------------------------
+//--------------------------------------------------------
+
+// MsgSwapSend contains a swap request that credits the swapped coin to the recipient.
+// The swap fails if the swapped coin, after the spread fee, is less than MinAskAmount.
+type MsgSwapSend struct {
+	Trader       sdk.AccAddress `json:"trader" yaml:"trader"`                 // Address of the trader
+	Recipient    sdk.AccAddress `json:"recipient" yaml:"recipient"`           // Address to credit the swapped coin
+	OfferCoin    sdk.Coin       `json:"offer_coin" yaml:"offer_coin"`         // Coin being offered
+	AskDenom     string         `json:"ask_denom" yaml:"ask_denom"`           // Denom of the coin to swap to
+	MinAskAmount sdk.Int        `json:"min_ask_amount" yaml:"min_ask_amount"` // Minimum amount of the ask coin to receive
+}
+
+// NewMsgSwapSend creates a MsgSwapSend instance
+func NewMsgSwapSend(traderAddress sdk.AccAddress, recipientAddress sdk.AccAddress, offerCoin sdk.Coin, askCoin string, minAskAmount sdk.Int) MsgSwapSend {
+	return MsgSwapSend{
+		Trader:       traderAddress,
+		Recipient:    recipientAddress,
+		OfferCoin:    offerCoin,
+		AskDenom:     askCoin,
+		MinAskAmount: minAskAmount,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgSwapSend) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgSwapSend) Type() string { return "swapsend" }
+
+// GetSignBytes Implements Msg
+func (msg MsgSwapSend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgSwapSend) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Trader}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgSwapSend) ValidateBasic() sdk.Error {
+	if len(msg.Trader) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Trader.String())
+	}
+
+	if len(msg.Recipient) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Recipient.String())
+	}
+
+	if msg.OfferCoin.Amount.LTE(sdk.ZeroInt()) {
+		return ErrInsufficientSwapCoins(DefaultCodespace, msg.OfferCoin.Amount)
+	}
+
+	if msg.OfferCoin.Denom == msg.AskDenom {
+		return ErrRecursiveSwap(DefaultCodespace, msg.AskDenom)
+	}
+
+	// MinAskAmount is left nil by messages omitting min_ask_amount
+	if msg.MinAskAmount == (sdk.Int{}) || msg.MinAskAmount.IsNegative() {
+		return sdk.ErrInvalidCoins("Invalid minimum ask amount: " + msg.MinAskAmount.String())
+	}
+
+	return nil
+}
+
+// String Implements Msg
+func (msg MsgSwapSend) String() string {
+	return fmt.Sprintf(`MsgSwapSend
+	trader:     %s, 
+	recipient:  %s, 
+	offer:      %s, 
+	ask:        %s, 
+	min_ask:    %s`,
+		msg.Trader, msg.Recipient, msg.OfferCoin, msg.AskDenom, msg.MinAskAmount)
+}
+
+//--------------------------------------------------------
+//--------------------------------------------------------
+
+// MsgRouteSwap contains a swap request that swaps the offer coin through the ask denoms in order;
+// the trader is credited with the coin of the last ask denom. The swap fails if that coin, after
+// the spread fees, is less than MinAskAmount.
+type MsgRouteSwap struct {
+	Trader       sdk.AccAddress `json:"trader" yaml:"trader"`                 // Address of the trader
+	OfferCoin    sdk.Coin       `json:"offer_coin" yaml:"offer_coin"`         // Coin being offered
+	AskDenoms    []string       `json:"ask_denoms" yaml:"ask_denoms"`         // Denoms of the coins to swap to, in order
+	MinAskAmount sdk.Int        `json:"min_ask_amount" yaml:"min_ask_amount"` // Minimum amount of the last ask coin to receive
+}
+
+// NewMsgRouteSwap creates a MsgRouteSwap instance
+func NewMsgRouteSwap(traderAddress sdk.AccAddress, offerCoin sdk.Coin, askDenoms []string, minAskAmount sdk.Int) MsgRouteSwap {
+	return MsgRouteSwap{
+		Trader:       traderAddress,
+		OfferCoin:    offerCoin,
+		AskDenoms:    askDenoms,
+		MinAskAmount: minAskAmount,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgRouteSwap) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgRouteSwap) Type() string { return "routeswap" }
+
+// GetSignBytes Implements Msg
+func (msg MsgRouteSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgRouteSwap) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Trader}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgRouteSwap) ValidateBasic() sdk.Error {
+	if len(msg.Trader) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Trader.String())
+	}
+
+	if msg.OfferCoin.Amount.LTE(sdk.ZeroInt()) {
+		return ErrInsufficientSwapCoins(DefaultCodespace, msg.OfferCoin.Amount)
+	}
+
+	// MinAskAmount is left nil by messages omitting min_ask_amount
+	if msg.MinAskAmount == (sdk.Int{}) || msg.MinAskAmount.IsNegative() {
+		return sdk.ErrInvalidCoins("Invalid minimum ask amount: " + msg.MinAskAmount.String())
+	}
+
+	return ValidateSwapRoute(msg.OfferCoin.Denom, msg.AskDenoms)
+}
+
+// String Implements Msg
+func (msg MsgRouteSwap) String() string {
+	return fmt.Sprintf(`MsgRouteSwap
+	trader:    %s, 
+	offer:     %s, 
+	asks:      %s, 
+	min_ask:   %s`,
+		msg.Trader, msg.OfferCoin, strings.Join(msg.AskDenoms, ","), msg.MinAskAmount)
+}
+
+// MaxSwapRouteHops is the highest number of swaps a route swap goes through; a route through
+// Luna and every Terra denom takes fewer
+const MaxSwapRouteHops = 8
+
+// ValidateSwapRoute checks the route of ask denoms is neither empty nor longer than MaxSwapRouteHops,
+// and no hop swaps to its own denom
+func ValidateSwapRoute(offerDenom string, askDenoms []string) sdk.Error {
+	if len(askDenoms) == 0 {
+		return ErrEmptySwapRoute(DefaultCodespace)
+	}
+
+	if len(askDenoms) > MaxSwapRouteHops {
+		return ErrSwapRouteTooLong(DefaultCodespace, len(askDenoms))
+	}
+
+	for _, askDenom := range askDenoms {
+		if offerDenom == askDenom {
+			return ErrRecursiveSwap(DefaultCodespace, askDenom)
+		}
+
+		offerDenom = askDenom
+	}
+
+	return nil
+}
+
+//--------------------------------------------------------
+//--------------------------------------------------------
+
+// MsgPlaceLimitOrder contains a request to place a limit order; the offer coin is escrowed
+// until the order is executed at or above the limit price, cancelled, or expired.
+type MsgPlaceLimitOrder struct {
+	Trader       sdk.AccAddress `json:"trader" yaml:"trader"`               // Address of the trader
+	OfferCoin    sdk.Coin       `json:"offer_coin" yaml:"offer_coin"`       // Coin being offered
+	AskDenom     string         `json:"ask_denom" yaml:"ask_denom"`         // Denom of the coin to swap to
+	LimitPrice   sdk.Dec        `json:"limit_price" yaml:"limit_price"`     // Minimum ask amount per offer amount, after the spread fee
+	ExpiryHeight int64          `json:"expiry_height" yaml:"expiry_height"` // Last block height the order can be executed at
+}
+
+// NewMsgPlaceLimitOrder creates a MsgPlaceLimitOrder instance
+func NewMsgPlaceLimitOrder(traderAddress sdk.AccAddress, offerCoin sdk.Coin, askCoin string,
+	limitPrice sdk.Dec, expiryHeight int64) MsgPlaceLimitOrder {
+	return MsgPlaceLimitOrder{
+		Trader:       traderAddress,
+		OfferCoin:    offerCoin,
+		AskDenom:     askCoin,
+		LimitPrice:   limitPrice,
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgPlaceLimitOrder) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgPlaceLimitOrder) Type() string { return "placelimitorder" }
+
+// GetSignBytes Implements Msg
+func (msg MsgPlaceLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Trader}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgPlaceLimitOrder) ValidateBasic() sdk.Error {
+	if len(msg.Trader) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Trader.String())
+	}
+
+	if msg.OfferCoin.Amount.LTE(sdk.ZeroInt()) {
+		return ErrInsufficientSwapCoins(DefaultCodespace, msg.OfferCoin.Amount)
+	}
+
+	if msg.OfferCoin.Denom == msg.AskDenom {
+		return ErrRecursiveSwap(DefaultCodespace, msg.AskDenom)
+	}
+
+	if msg.LimitPrice.IsNil() || !msg.LimitPrice.IsPositive() {
+		return sdk.ErrUnknownRequest("Limit price should be positive")
+	}
+
+	if _, ok := LimitOrderMinAskAmount(msg.LimitPrice, msg.OfferCoin.Amount); !ok {
+		return ErrInvalidLimitPrice(DefaultCodespace, msg.LimitPrice, msg.OfferCoin.Amount)
+	}
+
+	if msg.ExpiryHeight <= 0 {
+		return ErrInvalidExpiryHeight(DefaultCodespace, msg.ExpiryHeight, 0)
+	}
+
+	return nil
+}
+
+// String Implements Msg
+func (msg MsgPlaceLimitOrder) String() string {
+	return fmt.Sprintf(`MsgPlaceLimitOrder
+	trader:        %s, 
+	offer:         %s, 
+	ask:           %s, 
+	limit_price:   %s, 
+	expiry_height: %d`,
+		msg.Trader, msg.OfferCoin, msg.AskDenom, msg.LimitPrice, msg.ExpiryHeight)
+}
+
+//--------------------------------------------------------
+//--------------------------------------------------------
+
+// MsgCancelLimitOrder contains a request to cancel a resting limit order and refund its escrow
+type MsgCancelLimitOrder struct {
+	Trader  sdk.AccAddress `json:"trader" yaml:"trader"`     // Address of the trader
+	OrderID uint64         `json:"order_id" yaml:"order_id"` // ID of the order to cancel
+}
+
+// NewMsgCancelLimitOrder creates a MsgCancelLimitOrder instance
+func NewMsgCancelLimitOrder(traderAddress sdk.AccAddress, orderID uint64) MsgCancelLimitOrder {
+	return MsgCancelLimitOrder{
+		Trader:  traderAddress,
+		OrderID: orderID,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgCancelLimitOrder) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCancelLimitOrder) Type() string { return "cancellimitorder" }
+
+// GetSignBytes Implements Msg
+func (msg MsgCancelLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgCancelLimitOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Trader}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgCancelLimitOrder) ValidateBasic() sdk.Error {
+	if len(msg.Trader) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Trader.String())
+	}
+
+	return nil
+}
+
+// String Implements Msg
+func (msg MsgCancelLimitOrder) String() string {
+	return fmt.Sprintf(`MsgCancelLimitOrder
+	trader:    %s, 
+	order_id:  %d`,
+		msg.Trader, msg.OrderID)
+}
+
+//--------------------------------------------------------
+//--------------------------------------------------------
+
+// MsgCreateSwapSchedule contains a request to swap a fixed offer coin every Interval blocks, Executions times;
+// the offer coins of all the executions are escrowed until they are swapped or the schedule is cancelled.
+type MsgCreateSwapSchedule struct {
+	Trader     sdk.AccAddress `json:"trader" yaml:"trader"`         // Address of the trader
+	OfferCoin  sdk.Coin       `json:"offer_coin" yaml:"offer_coin"` // Coin being offered at every execution
+	AskDenom   string         `json:"ask_denom" yaml:"ask_denom"`   // Denom of the coin to swap to
+	Interval   int64          `json:"interval" yaml:"interval"`     // Number of blocks between executions
+	Executions int64          `json:"executions" yaml:"executions"` // Number of executions
+}
+
+// NewMsgCreateSwapSchedule creates a MsgCreateSwapSchedule instance
+func NewMsgCreateSwapSchedule(traderAddress sdk.AccAddress, offerCoin sdk.Coin, askCoin string,
+	interval int64, executions int64) MsgCreateSwapSchedule {
+	return MsgCreateSwapSchedule{
+		Trader:     traderAddress,
+		OfferCoin:  offerCoin,
+		AskDenom:   askCoin,
+		Interval:   interval,
+		Executions: executions,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgCreateSwapSchedule) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCreateSwapSchedule) Type() string { return "createswapschedule" }
+
+// GetSignBytes Implements Msg
+func (msg MsgCreateSwapSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgCreateSwapSchedule) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Trader}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgCreateSwapSchedule) ValidateBasic() sdk.Error {
+	if len(msg.Trader) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Trader.String())
+	}
+
+	if msg.OfferCoin.Amount.LTE(sdk.ZeroInt()) {
+		return ErrInsufficientSwapCoins(DefaultCodespace, msg.OfferCoin.Amount)
+	}
+
+	if msg.OfferCoin.Denom == msg.AskDenom {
+		return ErrRecursiveSwap(DefaultCodespace, msg.AskDenom)
+	}
+
+	if msg.Interval <= 0 || msg.Executions <= 0 {
+		return ErrInvalidSwapSchedule(DefaultCodespace, msg.Interval, msg.Executions)
+	}
+
+	if !IsValidSwapScheduleEscrow(msg.OfferCoin.Amount, msg.Executions) {
+		return ErrExceedsSwapScheduleEscrow(DefaultCodespace, msg.OfferCoin.Amount, msg.Executions)
+	}
+
+	return nil
+}
+
+// String Implements Msg
+func (msg MsgCreateSwapSchedule) String() string {
+	return fmt.Sprintf(`MsgCreateSwapSchedule
+	trader:     %s, 
+	offer:      %s, 
+	ask:        %s, 
+	interval:   %d, 
+	executions: %d`,
+		msg.Trader, msg.OfferCoin, msg.AskDenom, msg.Interval, msg.Executions)
+}
+
+//--------------------------------------------------------
+//--------------------------------------------------------
+
+// MsgCancelSwapSchedule contains a request to cancel a swap schedule and refund the escrow of its remaining executions
+type MsgCancelSwapSchedule struct {
+	Trader     sdk.AccAddress `json:"trader" yaml:"trader"`           // Address of the trader
+	ScheduleID uint64         `json:"schedule_id" yaml:"schedule_id"` // ID of the schedule to cancel
+}
+
+// NewMsgCancelSwapSchedule creates a MsgCancelSwapSchedule instance
+func NewMsgCancelSwapSchedule(traderAddress sdk.AccAddress, scheduleID uint64) MsgCancelSwapSchedule {
+	return MsgCancelSwapSchedule{
+		Trader:     traderAddress,
+		ScheduleID: scheduleID,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgCancelSwapSchedule) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCancelSwapSchedule) Type() string { return "cancelswapschedule" }
+
+// GetSignBytes Implements Msg
+func (msg MsgCancelSwapSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg
+func (msg MsgCancelSwapSchedule) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Trader}
+}
+
+// ValidateBasic Implements Msg
+func (msg MsgCancelSwapSchedule) ValidateBasic() sdk.Error {
+	if len(msg.Trader) == 0 {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Trader.String())
+	}
+
+	return nil
+}
+
+// String Implements Msg
+func (msg MsgCancelSwapSchedule) String() string {
+	return fmt.Sprintf(`MsgCancelSwapSchedule
+	trader:      %s, 
+	schedule_id: %d`,
+		msg.Trader, msg.ScheduleID)
+}
@@ -34,3 +34,159 @@ func TestMsgSwap(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgSwapSend(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(2, sdk.Coins{})
+
+	tests := []struct {
+		trader       sdk.AccAddress
+		recipient    sdk.AccAddress
+		offerCoin    sdk.Coin
+		askDenom     string
+		minAskAmount sdk.Int
+		expectPass   bool
+	}{
+		{addrs[0], addrs[1], sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt()), core.MicroSDRDenom, sdk.ZeroInt(), true},
+		{addrs[0], addrs[1], sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt()), core.MicroSDRDenom, sdk.OneInt(), true},
+		{sdk.AccAddress{}, addrs[1], sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt()), core.MicroSDRDenom, sdk.ZeroInt(), false},
+		{addrs[0], sdk.AccAddress{}, sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt()), core.MicroSDRDenom, sdk.ZeroInt(), false},
+		{addrs[0], addrs[1], sdk.NewCoin(core.MicroLunaDenom, sdk.ZeroInt()), core.MicroSDRDenom, sdk.ZeroInt(), false},
+		{addrs[0], addrs[1], sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt()), core.MicroLunaDenom, sdk.ZeroInt(), false},
+		{addrs[0], addrs[1], sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt()), core.MicroSDRDenom, sdk.NewInt(-1), false},
+		{addrs[0], addrs[1], sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt()), core.MicroSDRDenom, sdk.Int{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSwapSend(tc.trader, tc.recipient, tc.offerCoin, tc.askDenom, tc.minAskAmount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgRouteSwap(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	longRoute := make([]string, MaxSwapRouteHops+1)
+	for i := range longRoute {
+		longRoute[i] = core.MicroLunaDenom
+		if i%2 == 0 {
+			longRoute[i] = core.MicroSDRDenom
+		}
+	}
+
+	tests := []struct {
+		trader       sdk.AccAddress
+		offerCoin    sdk.Coin
+		askDenoms    []string
+		minAskAmount sdk.Int
+		expectPass   bool
+	}{
+		{addrs[0], sdk.NewCoin(core.MicroKRWDenom, sdk.OneInt()), []string{core.MicroSDRDenom, core.MicroLunaDenom}, sdk.ZeroInt(), true},
+		{addrs[0], sdk.NewCoin(core.MicroKRWDenom, sdk.OneInt()), []string{core.MicroLunaDenom}, sdk.OneInt(), true},
+		{sdk.AccAddress{}, sdk.NewCoin(core.MicroKRWDenom, sdk.OneInt()), []string{core.MicroLunaDenom}, sdk.ZeroInt(), false},
+		{addrs[0], sdk.NewCoin(core.MicroKRWDenom, sdk.ZeroInt()), []string{core.MicroLunaDenom}, sdk.ZeroInt(), false},
+		{addrs[0], sdk.NewCoin(core.MicroKRWDenom, sdk.OneInt()), []string{}, sdk.ZeroInt(), false},
+		{addrs[0], sdk.NewCoin(core.MicroKRWDenom, sdk.OneInt()), []string{core.MicroKRWDenom, core.MicroLunaDenom}, sdk.ZeroInt(), false},
+		{addrs[0], sdk.NewCoin(core.MicroKRWDenom, sdk.OneInt()), []string{core.MicroSDRDenom, core.MicroSDRDenom}, sdk.ZeroInt(), false},
+		{addrs[0], sdk.NewCoin(core.MicroKRWDenom, sdk.OneInt()), longRoute[:MaxSwapRouteHops], sdk.ZeroInt(), true},
+		{addrs[0], sdk.NewCoin(core.MicroKRWDenom, sdk.OneInt()), longRoute, sdk.ZeroInt(), false},
+		{addrs[0], sdk.NewCoin(core.MicroKRWDenom, sdk.OneInt()), []string{core.MicroLunaDenom}, sdk.NewInt(-1), false},
+		{addrs[0], sdk.NewCoin(core.MicroKRWDenom, sdk.OneInt()), []string{core.MicroLunaDenom}, sdk.Int{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgRouteSwap(tc.trader, tc.offerCoin, tc.askDenoms, tc.minAskAmount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgPlaceLimitOrder(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	tests := []struct {
+		trader       sdk.AccAddress
+		offerCoin    sdk.Coin
+		askDenom     string
+		limitPrice   sdk.Dec
+		expiryHeight int64
+		expectPass   bool
+	}{
+		{addrs[0], sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt()), core.MicroSDRDenom, sdk.OneDec(), 10, true},
+		{sdk.AccAddress{}, sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt()), core.MicroSDRDenom, sdk.OneDec(), 10, false},
+		{addrs[0], sdk.NewCoin(core.MicroLunaDenom, sdk.ZeroInt()), core.MicroSDRDenom, sdk.OneDec(), 10, false},
+		{addrs[0], sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt()), core.MicroLunaDenom, sdk.OneDec(), 10, false},
+		{addrs[0], sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt()), core.MicroSDRDenom, sdk.ZeroDec(), 10, false},
+		{addrs[0], sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt()), core.MicroSDRDenom, sdk.Dec{}, 10, false},
+		{addrs[0], sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt()), core.MicroSDRDenom, MaxLimitPrice.Add(sdk.OneDec()), 10, false},
+		{addrs[0], sdk.NewCoin(core.MicroLunaDenom, MaxLimitOrderAskAmount), core.MicroSDRDenom, sdk.NewDec(2), 10, false},
+		{addrs[0], sdk.NewCoin(core.MicroLunaDenom, sdk.NewIntWithDecimal(1, 70)), core.MicroSDRDenom, MaxLimitPrice, 10, false},
+		{addrs[0], sdk.NewCoin(core.MicroLunaDenom, sdk.OneInt()), core.MicroSDRDenom, sdk.OneDec(), 0, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgPlaceLimitOrder(tc.trader, tc.offerCoin, tc.askDenom, tc.limitPrice, tc.expiryHeight)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgCancelLimitOrder(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	msg := NewMsgCancelLimitOrder(addrs[0], 1)
+	require.Nil(t, msg.ValidateBasic())
+
+	msg = NewMsgCancelLimitOrder(sdk.AccAddress{}, 1)
+	require.NotNil(t, msg.ValidateBasic())
+}
+
+func TestMsgCreateSwapSchedule(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	tests := []struct {
+		trader     sdk.AccAddress
+		offerCoin  sdk.Coin
+		askDenom   string
+		interval   int64
+		executions int64
+		expectPass bool
+	}{
+		{addrs[0], sdk.NewCoin(core.MicroSDRDenom, sdk.OneInt()), core.MicroKRWDenom, 10, 5, true},
+		{sdk.AccAddress{}, sdk.NewCoin(core.MicroSDRDenom, sdk.OneInt()), core.MicroKRWDenom, 10, 5, false},
+		{addrs[0], sdk.NewCoin(core.MicroSDRDenom, sdk.ZeroInt()), core.MicroKRWDenom, 10, 5, false},
+		{addrs[0], sdk.NewCoin(core.MicroSDRDenom, sdk.OneInt()), core.MicroSDRDenom, 10, 5, false},
+		{addrs[0], sdk.NewCoin(core.MicroSDRDenom, sdk.OneInt()), core.MicroKRWDenom, 0, 5, false},
+		{addrs[0], sdk.NewCoin(core.MicroSDRDenom, sdk.OneInt()), core.MicroKRWDenom, 10, 0, false},
+		{addrs[0], sdk.NewCoin(core.MicroSDRDenom, MaxSwapScheduleEscrow), core.MicroKRWDenom, 10, 2, false},
+		{addrs[0], sdk.NewCoin(core.MicroSDRDenom, sdk.NewIntWithDecimal(1, 70)), core.MicroKRWDenom, 10, 1 << 62, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgCreateSwapSchedule(tc.trader, tc.offerCoin, tc.askDenom, tc.interval, tc.executions)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgCancelSwapSchedule(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	msg := NewMsgCancelSwapSchedule(addrs[0], 1)
+	require.Nil(t, msg.ValidateBasic())
+
+	msg = NewMsgCancelSwapSchedule(sdk.AccAddress{}, 1)
+	require.NotNil(t, msg.ValidateBasic())
+}
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Bounds of the limit orders, keeping their minimum ask amount clear of Int overflows
+var (
+	MaxLimitPrice          = sdk.NewDec(1000000000)       // highest ask amount per offer amount a limit order can ask for
+	MaxLimitOrderAskAmount = sdk.NewIntWithDecimal(1, 36) // highest minimum ask amount a limit order can ask for
+)
+
+// LimitOrder is a swap request resting in the market until the oracle exchange rate meets its limit price;
+// the offer coin is escrowed in the market module account until the order is executed, cancelled or expired.
+type LimitOrder struct {
+	OrderID      uint64         `json:"order_id" yaml:"order_id"`           // ID of the order
+	Trader       sdk.AccAddress `json:"trader" yaml:"trader"`               // Address of the trader
+	OfferCoin    sdk.Coin       `json:"offer_coin" yaml:"offer_coin"`       // Coin being offered
+	AskDenom     string         `json:"ask_denom" yaml:"ask_denom"`         // Denom of the coin to swap to
+	LimitPrice   sdk.Dec        `json:"limit_price" yaml:"limit_price"`     // Minimum ask amount per offer amount, after the spread fee
+	ExpiryHeight int64          `json:"expiry_height" yaml:"expiry_height"` // Last block height the order can be executed at
+}
+
+// NewLimitOrder creates a LimitOrder instance
+func NewLimitOrder(orderID uint64, trader sdk.AccAddress, offerCoin sdk.Coin,
+	askDenom string, limitPrice sdk.Dec, expiryHeight int64) LimitOrder {
+	return LimitOrder{
+		OrderID:      orderID,
+		Trader:       trader,
+		OfferCoin:    offerCoin,
+		AskDenom:     askDenom,
+		LimitPrice:   limitPrice,
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+// MinAskAmount returns the minimum amount of the ask coin the order can be executed for;
+// returns false if the order is out of the limit order bounds
+func (order LimitOrder) MinAskAmount() (sdk.Int, bool) {
+	return LimitOrderMinAskAmount(order.LimitPrice, order.OfferCoin.Amount)
+}
+
+// LimitOrderMinAskAmount returns the minimum ask amount of a limit order offering the given amount at the limit price;
+// returns false if the limit price is not within (0, MaxLimitPrice] or the ask amount exceeds MaxLimitOrderAskAmount
+func LimitOrderMinAskAmount(limitPrice sdk.Dec, offerAmount sdk.Int) (sdk.Int, bool) {
+	if limitPrice.IsNil() || !limitPrice.IsPositive() || limitPrice.GT(MaxLimitPrice) {
+		return sdk.ZeroInt(), false
+	}
+
+	// Bound the offer amount before multiplying, as the product itself can overflow
+	if offerAmount.ToDec().GT(MaxLimitOrderAskAmount.ToDec().Quo(limitPrice)) {
+		return sdk.ZeroInt(), false
+	}
+
+	return limitPrice.MulInt(offerAmount).Ceil().TruncateInt(), true
+}
+
+// IsExpired returns whether the order can no longer be executed after the given block height
+func (order LimitOrder) IsExpired(blockHeight int64) bool {
+	return blockHeight >= order.ExpiryHeight
+}
+
+// Validate checks the order is well formed
+func (order LimitOrder) Validate() error {
+	if len(order.Trader) == 0 {
+		return fmt.Errorf("limit order %d has an empty trader", order.OrderID)
+	}
+
+	if !order.OfferCoin.IsValid() || !order.OfferCoin.IsPositive() {
+		return fmt.Errorf("limit order %d has an invalid offer coin %s", order.OrderID, order.OfferCoin)
+	}
+
+	if order.OfferCoin.Denom == order.AskDenom {
+		return fmt.Errorf("limit order %d swaps to its own denom %s", order.OrderID, order.AskDenom)
+	}
+
+	if order.LimitPrice.IsNil() || !order.LimitPrice.IsPositive() {
+		return fmt.Errorf("limit order %d should have a positive limit price", order.OrderID)
+	}
+
+	if _, ok := order.MinAskAmount(); !ok {
+		return fmt.Errorf("limit order %d asks for more than the limit price %s or ask amount %s bounds",
+			order.OrderID, MaxLimitPrice, MaxLimitOrderAskAmount)
+	}
+
+	if order.ExpiryHeight <= 0 {
+		return fmt.Errorf("limit order %d should have a positive expiry height", order.OrderID)
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface
+func (order LimitOrder) String() string {
+	return fmt.Sprintf(`LimitOrder
+	OrderID:      %d
+	Trader:       %s
+	OfferCoin:    %s
+	AskDenom:     %s
+	LimitPrice:   %s
+	ExpiryHeight: %d`,
+		order.OrderID, order.Trader, order.OfferCoin, order.AskDenom, order.LimitPrice, order.ExpiryHeight)
+}
+
+// LimitOrders is a collection of LimitOrder
+type LimitOrders []LimitOrder
+
+// String implements fmt.Stringer interface
+func (orders LimitOrders) String() (out string) {
+	for _, order := range orders {
+		out += order.String() + "\n"
+	}
+	return
+}
@@ -5,23 +5,59 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+
+	core "github.com/terra-project/core/types"
 )
 
 // DefaultParamspace
 const DefaultParamspace = ModuleName
 
+// Spread models for swaps involving Luna
+const (
+	// SpreadModelConstantProduct charges the slippage of a virtual constant-product pool
+	SpreadModelConstantProduct = "constant_product"
+	// SpreadModelLinear charges a spread growing linearly with the daily Luna supply change
+	SpreadModelLinear = "linear"
+)
+
 // Parameter keys
 var (
-	ParamStoreKeyDailyLunaDeltaCap = []byte("dailylunadeltalimit")
-	ParamStoreKeyMaxSwapSpread     = []byte("maxswapspread")
-	ParamStoreKeyMinSwapSpread     = []byte("minswapspread")
+	ParamStoreKeyDailyLunaDeltaCap     = []byte("dailylunadeltalimit")
+	ParamStoreKeyMaxSwapSpread         = []byte("maxswapspread")
+	ParamStoreKeyMinSwapSpread         = []byte("minswapspread")
+	ParamStoreKeyBasePool              = []byte("basepool")
+	ParamStoreKeyPoolRecoveryPeriod    = []byte("poolrecoveryperiod")
+	ParamStoreKeySpreadModel           = []byte("spreadmodel")
+	ParamStoreKeyTobinTax              = []byte("tobintax")
+	ParamStoreKeyTobinTaxOverrides     = []byte("tobintaxoverrides")
+	ParamStoreKeyOracleFeeShare        = []byte("oraclefeeshare")
+	ParamStoreKeyLunaDeltaHardLimit    = []byte("lunadeltahardlimit")
+	ParamStoreKeyDenomParams           = []byte("denomparams")
+	ParamStoreKeyVolumeRetention       = []byte("volumeretention")
+	ParamStoreKeyTraderVolume          = []byte("tradervolume")
+	ParamStoreKeyMaxOraclePriceAge     = []byte("maxoraclepriceage")
+	ParamStoreKeyMaxLimitOrderMatches  = []byte("maxlimitordermatches")
+	ParamStoreKeyMaxScheduleExecutions = []byte("maxscheduleexecutions")
 )
 
 // Default parameter values
 var (
-	DefaultDailyLunaDeltaCap = sdk.NewDecWithPrec(5, 3) // 0.5%
-	DefaultMaxSwapSpread     = sdk.NewDec(1)            // 100%
-	DefaultMinSwapSpread     = sdk.NewDecWithPrec(2, 2) // 2%
+	DefaultDailyLunaDeltaCap     = sdk.NewDecWithPrec(5, 3)            // 0.5%
+	DefaultMaxSwapSpread         = sdk.NewDec(1)                       // 100%
+	DefaultMinSwapSpread         = sdk.NewDecWithPrec(2, 2)            // 2%
+	DefaultBasePool              = sdk.NewDec(250000 * core.MicroUnit) // 250,000 SDR = 250,000,000,000 usdr
+	DefaultPoolRecoveryPeriod    = core.BlocksPerDay                   // a day
+	DefaultSpreadModel           = SpreadModelConstantProduct
+	DefaultTobinTax              = sdk.NewDecWithPrec(25, 4) // 0.25%
+	DefaultTobinTaxOverrides     = TobinTaxList{}
+	DefaultOracleFeeShare        = sdk.OneDec()             // 100%
+	DefaultLunaDeltaHardLimit    = sdk.NewDecWithPrec(5, 2) // 5%
+	DefaultDenomParams           = DenomParamsList{}
+	DefaultVolumeRetention       = int64(52) // 52 epochs
+	DefaultTraderVolume          = false
+	DefaultMaxOraclePriceAge     = int64(1) // the last oracle tally only
+	DefaultMaxLimitOrderMatches  = int64(100)
+	DefaultMaxScheduleExecutions = int64(100)
 )
 
 var _ subspace.ParamSet = &Params{}
@@ -31,6 +67,27 @@ type Params struct {
 	DailyLunaDeltaCap sdk.Dec `json:"daily_luna_delta_cap" yaml:"daily_luna_delta_cap"`
 	MaxSwapSpread     sdk.Dec `json:"max_swap_spread" yaml:"max_swap_spread"`
 	MinSwapSpread     sdk.Dec `json:"min_swap_spread" yaml:"min_swap_spread"`
+
+	DenomParams DenomParamsList `json:"denom_params" yaml:"denom_params"` // per-denom overrides of the Luna swap params above
+
+	BasePool           sdk.Dec `json:"base_pool" yaml:"base_pool"`                       // equilibrium size of each virtual pool, in usdr
+	PoolRecoveryPeriod int64   `json:"pool_recovery_period" yaml:"pool_recovery_period"` // number of blocks for the pools to recover to equilibrium
+	SpreadModel        string  `json:"spread_model" yaml:"spread_model"`                 // spread model applied to swaps involving Luna
+
+	TobinTax          sdk.Dec      `json:"tobin_tax" yaml:"tobin_tax"`                     // default tax rate on Terra<>Terra swaps
+	TobinTaxOverrides TobinTaxList `json:"tobin_tax_overrides" yaml:"tobin_tax_overrides"` // per-denom tax rates overriding the default
+
+	OracleFeeShare sdk.Dec `json:"oracle_fee_share" yaml:"oracle_fee_share"` // share of swap fees sent to the oracle reward pool; the rest goes to the community pool
+
+	LunaDeltaHardLimit sdk.Dec `json:"luna_delta_hard_limit" yaml:"luna_delta_hard_limit"` // Luna supply change rate over the rolling window beyond which Luna swaps are refused
+
+	VolumeRetention int64 `json:"volume_retention" yaml:"volume_retention"` // number of epochs the swap volumes are kept for
+	TraderVolume    bool  `json:"trader_volume" yaml:"trader_volume"`       // whether the swap volume of every trader is recorded
+
+	MaxOraclePriceAge int64 `json:"max_oracle_price_age" yaml:"max_oracle_price_age"` // number of oracle vote periods an oracle price can be used for swaps after its tally
+
+	MaxLimitOrderMatches  int64 `json:"max_limit_order_matches" yaml:"max_limit_order_matches"` // number of resting limit orders tried for execution every block
+	MaxScheduleExecutions int64 `json:"max_schedule_executions" yaml:"max_schedule_executions"` // number of due swap schedules executed every block
 }
 
 // DefaultParams creates default market module parameters
@@ -39,6 +96,27 @@ func DefaultParams() Params {
 		DailyLunaDeltaCap: DefaultDailyLunaDeltaCap,
 		MaxSwapSpread:     DefaultMaxSwapSpread,
 		MinSwapSpread:     DefaultMinSwapSpread,
+
+		DenomParams: DefaultDenomParams,
+
+		BasePool:           DefaultBasePool,
+		PoolRecoveryPeriod: DefaultPoolRecoveryPeriod,
+		SpreadModel:        DefaultSpreadModel,
+
+		TobinTax:          DefaultTobinTax,
+		TobinTaxOverrides: DefaultTobinTaxOverrides,
+
+		OracleFeeShare: DefaultOracleFeeShare,
+
+		LunaDeltaHardLimit: DefaultLunaDeltaHardLimit,
+
+		VolumeRetention: DefaultVolumeRetention,
+		TraderVolume:    DefaultTraderVolume,
+
+		MaxOraclePriceAge: DefaultMaxOraclePriceAge,
+
+		MaxLimitOrderMatches:  DefaultMaxLimitOrderMatches,
+		MaxScheduleExecutions: DefaultMaxScheduleExecutions,
 	}
 }
 
@@ -53,6 +131,42 @@ func (params Params) Validate() error {
 	if params.MaxSwapSpread.LT(params.MinSwapSpread) || params.MaxSwapSpread.GT(sdk.OneDec()) {
 		return fmt.Errorf("market maximum swap spead should be larger or equal to the minimum, is %s", params.MaxSwapSpread.String())
 	}
+	if err := params.DenomParams.Validate(); err != nil {
+		return err
+	}
+	if !params.BasePool.IsPositive() {
+		return fmt.Errorf("market base pool should be positive, is %s", params.BasePool.String())
+	}
+	if params.PoolRecoveryPeriod <= 0 {
+		return fmt.Errorf("market pool recovery period should be positive, is %d", params.PoolRecoveryPeriod)
+	}
+	if params.SpreadModel != SpreadModelConstantProduct && params.SpreadModel != SpreadModelLinear {
+		return fmt.Errorf("market spread model should be %s or %s, is %s", SpreadModelConstantProduct, SpreadModelLinear, params.SpreadModel)
+	}
+	if params.TobinTax.IsNegative() || params.TobinTax.GT(sdk.OneDec()) {
+		return fmt.Errorf("market tobin tax should be within [0, 1], is %s", params.TobinTax.String())
+	}
+	if err := params.TobinTaxOverrides.Validate(); err != nil {
+		return err
+	}
+	if params.OracleFeeShare.IsNegative() || params.OracleFeeShare.GT(sdk.OneDec()) {
+		return fmt.Errorf("market oracle fee share should be within [0, 1], is %s", params.OracleFeeShare.String())
+	}
+	if !params.LunaDeltaHardLimit.IsPositive() || params.LunaDeltaHardLimit.GT(sdk.OneDec()) {
+		return fmt.Errorf("market luna delta hard limit should be within (0, 1], is %s", params.LunaDeltaHardLimit.String())
+	}
+	if params.VolumeRetention <= 0 {
+		return fmt.Errorf("market volume retention should be positive, is %d", params.VolumeRetention)
+	}
+	if params.MaxOraclePriceAge <= 0 {
+		return fmt.Errorf("market max oracle price age should be positive, is %d", params.MaxOraclePriceAge)
+	}
+	if params.MaxLimitOrderMatches <= 0 {
+		return fmt.Errorf("market max limit order matches should be positive, is %d", params.MaxLimitOrderMatches)
+	}
+	if params.MaxScheduleExecutions <= 0 {
+		return fmt.Errorf("market max schedule executions should be positive, is %d", params.MaxScheduleExecutions)
+	}
 
 	return nil
 }
@@ -65,14 +179,44 @@ func (params *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{Key: ParamStoreKeyDailyLunaDeltaCap, Value: &params.DailyLunaDeltaCap},
 		{Key: ParamStoreKeyMaxSwapSpread, Value: &params.MaxSwapSpread},
 		{Key: ParamStoreKeyMinSwapSpread, Value: &params.MinSwapSpread},
+		{Key: ParamStoreKeyBasePool, Value: &params.BasePool},
+		{Key: ParamStoreKeyPoolRecoveryPeriod, Value: &params.PoolRecoveryPeriod},
+		{Key: ParamStoreKeySpreadModel, Value: &params.SpreadModel},
+		{Key: ParamStoreKeyTobinTax, Value: &params.TobinTax},
+		{Key: ParamStoreKeyTobinTaxOverrides, Value: &params.TobinTaxOverrides},
+		{Key: ParamStoreKeyOracleFeeShare, Value: &params.OracleFeeShare},
+		{Key: ParamStoreKeyLunaDeltaHardLimit, Value: &params.LunaDeltaHardLimit},
+		{Key: ParamStoreKeyDenomParams, Value: &params.DenomParams},
+		{Key: ParamStoreKeyVolumeRetention, Value: &params.VolumeRetention},
+		{Key: ParamStoreKeyTraderVolume, Value: &params.TraderVolume},
+		{Key: ParamStoreKeyMaxOraclePriceAge, Value: &params.MaxOraclePriceAge},
+		{Key: ParamStoreKeyMaxLimitOrderMatches, Value: &params.MaxLimitOrderMatches},
+		{Key: ParamStoreKeyMaxScheduleExecutions, Value: &params.MaxScheduleExecutions},
 	}
 }
 
 // implements fmt.Stringer
 func (params Params) String() string {
-	return fmt.Sprintf(`Treasury Params:
+	return fmt.Sprintf(`Market Params:
   DailyLunaDeltaCap:        %s
   MaxSwapSpread:            %s
-	MinSwapSpread:            %s
-	`, params.DailyLunaDeltaCap, params.MaxSwapSpread, params.MinSwapSpread)
+  MinSwapSpread:            %s
+  DenomParams:              %s
+  BasePool:                 %s
+  PoolRecoveryPeriod:       %d
+  SpreadModel:              %s
+  TobinTax:                 %s
+  TobinTaxOverrides:        %s
+  OracleFeeShare:           %s
+  LunaDeltaHardLimit:       %s
+  VolumeRetention:          %d
+  TraderVolume:             %t
+  MaxOraclePriceAge:        %d
+  MaxLimitOrderMatches:     %d
+  MaxScheduleExecutions:    %d
+	`, params.DailyLunaDeltaCap, params.MaxSwapSpread, params.MinSwapSpread,
+		params.DenomParams, params.BasePool, params.PoolRecoveryPeriod, params.SpreadModel,
+		params.TobinTax, params.TobinTaxOverrides, params.OracleFeeShare,
+		params.LunaDeltaHardLimit, params.VolumeRetention, params.TraderVolume, params.MaxOraclePriceAge,
+		params.MaxLimitOrderMatches, params.MaxScheduleExecutions)
 }
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/terra-project/core/x/gov"
+)
+
+const (
+	// ProposalTypeCircuitBreaker defines the type for a CircuitBreakerProposal
+	ProposalTypeCircuitBreaker = "CircuitBreaker"
+
+	// ProposalTypeDenomParamsUpdate defines the type for a DenomParamsUpdateProposal
+	ProposalTypeDenomParamsUpdate = "DenomParamsUpdate"
+)
+
+// Assert CircuitBreakerProposal implements govtypes.Content at compile-time
+var _ gov.Content = CircuitBreakerProposal{}
+
+func init() {
+	gov.RegisterProposalType(ProposalTypeCircuitBreaker)
+	gov.RegisterProposalType(ProposalTypeDenomParamsUpdate)
+}
+
+// CircuitBreakerProposal trips or resets the market circuit breaker on Luna swaps
+type CircuitBreakerProposal struct {
+	Title       string `json:"title" yaml:"title"`             // Title of the Proposal
+	Description string `json:"description" yaml:"description"` // Description of the Proposal
+	Halt        bool   `json:"halt" yaml:"halt"`               // true to halt Luna swaps, false to resume them and reset the Luna supply change
+}
+
+// NewCircuitBreakerProposal creates an CircuitBreakerProposal.
+func NewCircuitBreakerProposal(title, description string, halt bool) CircuitBreakerProposal {
+	return CircuitBreakerProposal{title, description, halt}
+}
+
+// GetTitle returns the title of an CircuitBreakerProposal.
+func (p CircuitBreakerProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an CircuitBreakerProposal.
+func (p CircuitBreakerProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an CircuitBreakerProposal.
+func (CircuitBreakerProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an CircuitBreakerProposal.
+func (p CircuitBreakerProposal) ProposalType() string { return ProposalTypeCircuitBreaker }
+
+// ValidateBasic runs basic stateless validity checks
+func (p CircuitBreakerProposal) ValidateBasic() sdk.Error {
+	return gov.ValidateAbstract(DefaultCodespace, p)
+}
+
+// String implements the Stringer interface.
+func (p CircuitBreakerProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Circuit Breaker Proposal:
+  Title:        %s
+  Description:  %s
+  Halt:         %t
+`, p.Title, p.Description, p.Halt))
+	return b.String()
+}
+
+// DenomParamsUpdateProposal replaces the param overrides of a single denom, or removes them
+type DenomParamsUpdateProposal struct {
+	Title       string      `json:"title" yaml:"title"`               // Title of the Proposal
+	Description string      `json:"description" yaml:"description"`   // Description of the Proposal
+	DenomParams DenomParams `json:"denom_params" yaml:"denom_params"` // target overrides of the denom
+	Remove      bool        `json:"remove" yaml:"remove"`             // true to remove the overrides of the denom
+}
+
+// NewDenomParamsUpdateProposal creates an DenomParamsUpdateProposal.
+func NewDenomParamsUpdateProposal(title, description string, denomParams DenomParams, remove bool) DenomParamsUpdateProposal {
+	return DenomParamsUpdateProposal{title, description, denomParams, remove}
+}
+
+// GetTitle returns the title of an DenomParamsUpdateProposal.
+func (p DenomParamsUpdateProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an DenomParamsUpdateProposal.
+func (p DenomParamsUpdateProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an DenomParamsUpdateProposal.
+func (DenomParamsUpdateProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an DenomParamsUpdateProposal.
+func (p DenomParamsUpdateProposal) ProposalType() string { return ProposalTypeDenomParamsUpdate }
+
+// ValidateBasic runs basic stateless validity checks
+func (p DenomParamsUpdateProposal) ValidateBasic() sdk.Error {
+	err := gov.ValidateAbstract(DefaultCodespace, p)
+	if err != nil {
+		return err
+	}
+
+	if p.Remove {
+		if len(p.DenomParams.Denom) == 0 {
+			return sdk.ErrInvalidCoins("Invalid denom params: empty denom")
+		}
+
+		return nil
+	}
+
+	if err := p.DenomParams.Validate(); err != nil {
+		return sdk.ErrInvalidCoins("Invalid denom params: " + err.Error())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p DenomParamsUpdateProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Denom Params Update Proposal:
+  Title:        %s
+  Description:  %s
+  DenomParams:  %s
+  Remove:       %t
+`, p.Title, p.Description, p.DenomParams, p.Remove))
+	return b.String()
+}
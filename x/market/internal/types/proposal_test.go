@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+)
+
+func TestCircuitBreakerProposal(t *testing.T) {
+	p := NewCircuitBreakerProposal("Test", "description", true)
+	require.Equal(t, "Test", p.GetTitle())
+	require.Equal(t, "description", p.GetDescription())
+	require.Equal(t, RouterKey, p.ProposalRoute())
+	require.Equal(t, ProposalTypeCircuitBreaker, p.ProposalType())
+	require.Nil(t, p.ValidateBasic())
+
+	p = NewCircuitBreakerProposal("", "description", true)
+	require.Error(t, p.ValidateBasic())
+
+	p = NewCircuitBreakerProposal("Test", "", false)
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestDenomParamsUpdateProposal(t *testing.T) {
+	denomParams := NewDenomParams(core.MicroGBPDenom, sdk.NewDecWithPrec(1, 3), sdk.NewDecWithPrec(5, 2), sdk.OneDec())
+	p := NewDenomParamsUpdateProposal("Test", "description", denomParams, false)
+	require.Equal(t, RouterKey, p.ProposalRoute())
+	require.Equal(t, ProposalTypeDenomParamsUpdate, p.ProposalType())
+	require.Nil(t, p.ValidateBasic())
+
+	invalidDenomParams := denomParams
+	invalidDenomParams.MaxSwapSpread = sdk.NewDecWithPrec(1, 2)
+	p = NewDenomParamsUpdateProposal("Test", "description", invalidDenomParams, false)
+	require.Error(t, p.ValidateBasic())
+
+	// removal needs the denom only
+	p = NewDenomParamsUpdateProposal("Test", "description", DenomParams{Denom: core.MicroGBPDenom}, true)
+	require.Nil(t, p.ValidateBasic())
+
+	p = NewDenomParamsUpdateProposal("Test", "description", DenomParams{}, true)
+	require.Error(t, p.ValidateBasic())
+}
@@ -6,9 +6,21 @@ import (
 
 // query endpoints supported by the oracle Querier
 const (
-	QuerySwap            = "swap"
-	QueryPrevDayIssuance = "prevDayIssuance"
-	QueryParameters      = "parameters"
+	QuerySwap             = "swap"
+	QueryRouteSwap        = "routeSwap"
+	QuerySwapSimulation   = "swapSimulation"
+	QueryPrevDayIssuance  = "prevDayIssuance"
+	QueryIssuanceHistory  = "issuanceHistory"
+	QueryTerraPoolDelta   = "terraPoolDelta"
+	QueryLimitOrder       = "limitOrder"
+	QueryLimitOrders      = "limitOrders"
+	QueryTobinTax         = "tobinTax"
+	QuerySwapFeeProceeds  = "swapFeeProceeds"
+	QuerySwapVolume       = "swapVolume"
+	QueryTraderSwapVolume = "traderSwapVolume"
+	QuerySwapSchedule     = "swapSchedule"
+	QuerySwapSchedules    = "swapSchedules"
+	QueryParameters       = "parameters"
 )
 
 // QuerySwapParams for query
@@ -24,3 +36,120 @@ func NewQuerySwapParams(offerCoin sdk.Coin, askDenom string) QuerySwapParams {
 		AskDenom:  askDenom,
 	}
 }
+
+// QueryRouteSwapParams for query
+// - 'custom/market/routeSwap'
+type QueryRouteSwapParams struct {
+	OfferCoin sdk.Coin
+	AskDenoms []string
+}
+
+// NewQueryRouteSwapParams returns params for a route swap simulation query
+func NewQueryRouteSwapParams(offerCoin sdk.Coin, askDenoms []string) QueryRouteSwapParams {
+	return QueryRouteSwapParams{
+		OfferCoin: offerCoin,
+		AskDenoms: askDenoms,
+	}
+}
+
+// QueryLimitOrderParams for query
+// - 'custom/market/limitOrder'
+type QueryLimitOrderParams struct {
+	OrderID uint64
+}
+
+// NewQueryLimitOrderParams returns params for a limit order query
+func NewQueryLimitOrderParams(orderID uint64) QueryLimitOrderParams {
+	return QueryLimitOrderParams{orderID}
+}
+
+// QueryLimitOrdersParams for query
+// - 'custom/market/limitOrders'
+type QueryLimitOrdersParams struct {
+	Trader      sdk.AccAddress // optional; all orders are returned if empty
+	Page, Limit int
+}
+
+// NewQueryLimitOrdersParams returns params for a paginated limit orders query
+func NewQueryLimitOrdersParams(trader sdk.AccAddress, page, limit int) QueryLimitOrdersParams {
+	return QueryLimitOrdersParams{trader, page, limit}
+}
+
+// QuerySwapScheduleParams for query
+// - 'custom/market/swapSchedule'
+type QuerySwapScheduleParams struct {
+	ScheduleID uint64
+}
+
+// NewQuerySwapScheduleParams returns params for a swap schedule query
+func NewQuerySwapScheduleParams(scheduleID uint64) QuerySwapScheduleParams {
+	return QuerySwapScheduleParams{scheduleID}
+}
+
+// QuerySwapSchedulesParams for query
+// - 'custom/market/swapSchedules'
+type QuerySwapSchedulesParams struct {
+	Trader      sdk.AccAddress // optional; all schedules are returned if empty
+	Page, Limit int
+}
+
+// NewQuerySwapSchedulesParams returns params for a paginated swap schedules query
+func NewQuerySwapSchedulesParams(trader sdk.AccAddress, page, limit int) QuerySwapSchedulesParams {
+	return QuerySwapSchedulesParams{trader, page, limit}
+}
+
+// QueryTobinTaxParams for query
+// - 'custom/market/tobinTax'
+type QueryTobinTaxParams struct {
+	OfferDenom string
+	AskDenom   string
+}
+
+// NewQueryTobinTaxParams returns params for a Tobin tax rate query
+func NewQueryTobinTaxParams(offerDenom string, askDenom string) QueryTobinTaxParams {
+	return QueryTobinTaxParams{
+		OfferDenom: offerDenom,
+		AskDenom:   askDenom,
+	}
+}
+
+// QuerySwapFeeProceedsParams for query
+// - 'custom/market/swapFeeProceeds'
+type QuerySwapFeeProceedsParams struct {
+	Epoch int64
+}
+
+// NewQuerySwapFeeProceedsParams returns params for swap fee proceeds query
+func NewQuerySwapFeeProceedsParams(epoch int64) QuerySwapFeeProceedsParams {
+	return QuerySwapFeeProceedsParams{
+		Epoch: epoch,
+	}
+}
+
+// QuerySwapVolumeParams for query
+// - 'custom/market/swapVolume'
+type QuerySwapVolumeParams struct {
+	Epoch int64
+}
+
+// NewQuerySwapVolumeParams returns params for swap volume query
+func NewQuerySwapVolumeParams(epoch int64) QuerySwapVolumeParams {
+	return QuerySwapVolumeParams{
+		Epoch: epoch,
+	}
+}
+
+// QueryTraderSwapVolumeParams for query
+// - 'custom/market/traderSwapVolume'
+type QueryTraderSwapVolumeParams struct {
+	Epoch  int64
+	Trader sdk.AccAddress
+}
+
+// NewQueryTraderSwapVolumeParams returns params for trader swap volume query
+func NewQueryTraderSwapVolumeParams(epoch int64, trader sdk.AccAddress) QueryTraderSwapVolumeParams {
+	return QueryTraderSwapVolumeParams{
+		Epoch:  epoch,
+		Trader: trader,
+	}
+}
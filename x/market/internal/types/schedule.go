@@ -0,0 +1,112 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxSwapScheduleEscrow is the highest amount of offer coins a swap schedule can escrow for its executions,
+// keeping the escrow clear of Int overflows
+var MaxSwapScheduleEscrow = sdk.NewIntWithDecimal(1, 36)
+
+// IsValidSwapScheduleEscrow returns whether the escrow of the given number of executions of the offer amount
+// is within MaxSwapScheduleEscrow
+func IsValidSwapScheduleEscrow(offerAmount sdk.Int, executions int64) bool {
+	return executions > 0 && offerAmount.LTE(MaxSwapScheduleEscrow.QuoRaw(executions))
+}
+
+// SwapSchedule is a recurring swap of a fixed offer coin executed by the market every Interval blocks;
+// the offer coins of the remaining executions are escrowed in the swap schedule escrow account
+// until they are swapped or the schedule is cancelled.
+type SwapSchedule struct {
+	ScheduleID uint64         `json:"schedule_id" yaml:"schedule_id"` // ID of the schedule
+	Trader     sdk.AccAddress `json:"trader" yaml:"trader"`           // Address of the trader
+	OfferCoin  sdk.Coin       `json:"offer_coin" yaml:"offer_coin"`   // Coin being offered at every execution
+	AskDenom   string         `json:"ask_denom" yaml:"ask_denom"`     // Denom of the coin to swap to
+	Interval   int64          `json:"interval" yaml:"interval"`       // Number of blocks between executions
+	Remaining  int64          `json:"remaining" yaml:"remaining"`     // Number of executions left
+	NextHeight int64          `json:"next_height" yaml:"next_height"` // Block height of the next execution
+}
+
+// NewSwapSchedule creates a SwapSchedule instance
+func NewSwapSchedule(scheduleID uint64, trader sdk.AccAddress, offerCoin sdk.Coin,
+	askDenom string, interval int64, remaining int64, nextHeight int64) SwapSchedule {
+	return SwapSchedule{
+		ScheduleID: scheduleID,
+		Trader:     trader,
+		OfferCoin:  offerCoin,
+		AskDenom:   askDenom,
+		Interval:   interval,
+		Remaining:  remaining,
+		NextHeight: nextHeight,
+	}
+}
+
+// Escrow returns the offer coins escrowed for the remaining executions
+func (schedule SwapSchedule) Escrow() sdk.Coin {
+	return sdk.NewCoin(schedule.OfferCoin.Denom, schedule.OfferCoin.Amount.MulRaw(schedule.Remaining))
+}
+
+// IsDue returns whether the schedule is to be executed at the given block height
+func (schedule SwapSchedule) IsDue(blockHeight int64) bool {
+	return blockHeight >= schedule.NextHeight
+}
+
+// Validate checks the schedule is well formed
+func (schedule SwapSchedule) Validate() error {
+	if len(schedule.Trader) == 0 {
+		return fmt.Errorf("swap schedule %d has an empty trader", schedule.ScheduleID)
+	}
+
+	if !schedule.OfferCoin.IsValid() || !schedule.OfferCoin.IsPositive() {
+		return fmt.Errorf("swap schedule %d has an invalid offer coin %s", schedule.ScheduleID, schedule.OfferCoin)
+	}
+
+	if schedule.OfferCoin.Denom == schedule.AskDenom {
+		return fmt.Errorf("swap schedule %d swaps to its own denom %s", schedule.ScheduleID, schedule.AskDenom)
+	}
+
+	if schedule.Interval <= 0 {
+		return fmt.Errorf("swap schedule %d should have a positive interval", schedule.ScheduleID)
+	}
+
+	if schedule.Remaining <= 0 {
+		return fmt.Errorf("swap schedule %d should have remaining executions", schedule.ScheduleID)
+	}
+
+	if !IsValidSwapScheduleEscrow(schedule.OfferCoin.Amount, schedule.Remaining) {
+		return fmt.Errorf("swap schedule %d escrows more than %s", schedule.ScheduleID, MaxSwapScheduleEscrow)
+	}
+
+	if schedule.NextHeight <= 0 {
+		return fmt.Errorf("swap schedule %d should have a positive next height", schedule.ScheduleID)
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface
+func (schedule SwapSchedule) String() string {
+	return fmt.Sprintf(`SwapSchedule
+	ScheduleID: %d
+	Trader:     %s
+	OfferCoin:  %s
+	AskDenom:   %s
+	Interval:   %d
+	Remaining:  %d
+	NextHeight: %d`,
+		schedule.ScheduleID, schedule.Trader, schedule.OfferCoin, schedule.AskDenom,
+		schedule.Interval, schedule.Remaining, schedule.NextHeight)
+}
+
+// SwapSchedules is a collection of SwapSchedule
+type SwapSchedules []SwapSchedule
+
+// String implements fmt.Stringer interface
+func (schedules SwapSchedules) String() (out string) {
+	for _, schedule := range schedules {
+		out += schedule.String() + "\n"
+	}
+	return
+}
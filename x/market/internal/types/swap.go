@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SwapHop is the result of a single swap; the swap coin is net of the swap fee
+type SwapHop struct {
+	OfferCoin sdk.Coin `json:"offer_coin" yaml:"offer_coin"`
+	SwapCoin  sdk.Coin `json:"swap_coin" yaml:"swap_coin"`
+	SwapFee   sdk.Coin `json:"swap_fee" yaml:"swap_fee"`
+}
+
+// NewSwapHop creates a SwapHop instance
+func NewSwapHop(offerCoin sdk.Coin, swapCoin sdk.Coin, swapFee sdk.Coin) SwapHop {
+	return SwapHop{
+		OfferCoin: offerCoin,
+		SwapCoin:  swapCoin,
+		SwapFee:   swapFee,
+	}
+}
+
+// String implements fmt.Stringer interface
+func (sh SwapHop) String() string {
+	return fmt.Sprintf(`SwapHop
+	OfferCoin: %s
+	SwapCoin:  %s
+	SwapFee:   %s`,
+		sh.OfferCoin, sh.SwapCoin, sh.SwapFee)
+}
+
+// SwapHops is a collection of SwapHop
+type SwapHops []SwapHop
+
+// String implements fmt.Stringer interface
+func (hops SwapHops) String() (out string) {
+	for _, hop := range hops {
+		out += hop.String() + "\n"
+	}
+	return
+}
+
+// SwapSimulation is the breakdown of a simulated swap
+type SwapSimulation struct {
+	OfferCoin       sdk.Coin `json:"offer_coin" yaml:"offer_coin"`               // Coin being offered
+	OfferRate       sdk.Dec  `json:"offer_rate" yaml:"offer_rate"`               // Exchange rate of the offer denom to Luna
+	AskRate         sdk.Dec  `json:"ask_rate" yaml:"ask_rate"`                   // Exchange rate of the ask denom to Luna
+	LunaDeltaBefore sdk.Dec  `json:"luna_delta_before" yaml:"luna_delta_before"` // Luna supply change rate over the rolling window before the swap
+	LunaDeltaAfter  sdk.Dec  `json:"luna_delta_after" yaml:"luna_delta_after"`   // Luna supply change rate over the rolling window after the swap
+	Spread          sdk.Dec  `json:"spread" yaml:"spread"`                       // Spread or Tobin tax rate charged on the swap
+	SwapFee         sdk.Coin `json:"swap_fee" yaml:"swap_fee"`                   // Fee withheld from the swapped coin
+	ReturnCoin      sdk.Coin `json:"return_coin" yaml:"return_coin"`             // Swapped coin net of the fee
+}
+
+// String implements fmt.Stringer interface
+func (ss SwapSimulation) String() string {
+	return fmt.Sprintf(`SwapSimulation
+	OfferCoin:       %s
+	OfferRate:       %s
+	AskRate:         %s
+	LunaDeltaBefore: %s
+	LunaDeltaAfter:  %s
+	Spread:          %s
+	SwapFee:         %s
+	ReturnCoin:      %s`,
+		ss.OfferCoin, ss.OfferRate, ss.AskRate, ss.LunaDeltaBefore, ss.LunaDeltaAfter,
+		ss.Spread, ss.SwapFee, ss.ReturnCoin)
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TobinTax is the Tobin tax rate charged on Terra<>Terra swaps involving the denom
+type TobinTax struct {
+	Denom   string  `json:"denom" yaml:"denom"`
+	TaxRate sdk.Dec `json:"tax_rate" yaml:"tax_rate"`
+}
+
+// NewTobinTax creates a TobinTax instance
+func NewTobinTax(denom string, taxRate sdk.Dec) TobinTax {
+	return TobinTax{
+		Denom:   denom,
+		TaxRate: taxRate,
+	}
+}
+
+// String implements fmt.Stringer interface
+func (tt TobinTax) String() string {
+	return fmt.Sprintf("%s: %s", tt.Denom, tt.TaxRate)
+}
+
+// TobinTaxList is a collection of per-denom Tobin tax rates
+type TobinTaxList []TobinTax
+
+// String implements fmt.Stringer interface
+func (tl TobinTaxList) String() string {
+	out := make([]string, len(tl))
+	for i, tt := range tl {
+		out[i] = tt.String()
+	}
+	return strings.Join(out, ", ")
+}
+
+// RateOf returns the Tobin tax rate of the denom, and whether the list has it
+func (tl TobinTaxList) RateOf(denom string) (sdk.Dec, bool) {
+	for _, tt := range tl {
+		if tt.Denom == denom {
+			return tt.TaxRate, true
+		}
+	}
+
+	return sdk.ZeroDec(), false
+}
+
+// Validate checks every rate is within [0, 1], and no denom is listed twice
+func (tl TobinTaxList) Validate() error {
+	denoms := make(map[string]bool)
+	for _, tt := range tl {
+		if len(tt.Denom) == 0 {
+			return fmt.Errorf("tobin tax denom should not be empty")
+		}
+
+		if tt.TaxRate.IsNil() || tt.TaxRate.IsNegative() || tt.TaxRate.GT(sdk.OneDec()) {
+			return fmt.Errorf("tobin tax rate of %s should be within [0, 1], is %s", tt.Denom, tt.TaxRate)
+		}
+
+		if denoms[tt.Denom] {
+			return fmt.Errorf("tobin tax of %s is listed twice", tt.Denom)
+		}
+
+		denoms[tt.Denom] = true
+	}
+
+	return nil
+}
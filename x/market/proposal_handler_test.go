@@ -0,0 +1,95 @@
+package market
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/market/internal/keeper"
+	"github.com/terra-project/core/x/market/internal/types"
+)
+
+func testCircuitBreakerProposal(halt bool) types.CircuitBreakerProposal {
+	return types.NewCircuitBreakerProposal(
+		"Test",
+		"description",
+		halt,
+	)
+}
+
+func TestCircuitBreakerProposalHandler(t *testing.T) {
+	input, h := setup(t)
+	hdlr := NewMarketPolicyUpdateHandler(input.MarketKeeper)
+
+	// trip the breaker
+	require.NoError(t, hdlr(input.Ctx, testCircuitBreakerProposal(true)))
+	require.True(t, input.MarketKeeper.GetSwapHalted(input.Ctx))
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	swapMsg := NewMsgSwap(keeper.Addrs[0], offerCoin, core.MicroSDRDenom)
+	res := h(input.Ctx, swapMsg)
+	require.False(t, res.IsOK())
+	require.Equal(t, CodeCircuitBreaker, res.Code)
+
+	// reset the breaker
+	require.NoError(t, hdlr(input.Ctx, testCircuitBreakerProposal(false)))
+	require.False(t, input.MarketKeeper.GetSwapHalted(input.Ctx))
+
+	res = h(input.Ctx, swapMsg)
+	require.True(t, res.IsOK())
+}
+
+func TestCircuitBreakerProposalResetsSupplyTrip(t *testing.T) {
+	input, h := setup(t)
+	hdlr := NewMarketPolicyUpdateHandler(input.MarketKeeper)
+
+	input.MarketKeeper.RecordHourlyIssuance(input.Ctx)
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerHour)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, randomPrice)
+
+	// trip the breaker on the supply change
+	limit := input.MarketKeeper.LunaDeltaHardLimit(input.Ctx)
+	issuance := input.SupplyKeeper.GetSupply(input.Ctx).GetTotal().AmountOf(core.MicroLunaDenom)
+	mintCoin := sdk.NewCoin(core.MicroLunaDenom, limit.MulInt64(2).MulInt(issuance).TruncateInt())
+	require.NoError(t, input.SupplyKeeper.MintCoins(input.Ctx, ModuleName, sdk.NewCoins(mintCoin)))
+
+	offerCoin := sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(10))
+	swapMsg := NewMsgSwap(keeper.Addrs[0], offerCoin, core.MicroSDRDenom)
+	res := h(input.Ctx, swapMsg)
+	require.Equal(t, CodeCircuitBreaker, res.Code)
+
+	// a governance reset resumes Luna swaps within the rolling window
+	require.NoError(t, hdlr(input.Ctx, testCircuitBreakerProposal(false)))
+	res = h(input.Ctx, swapMsg)
+	require.True(t, res.IsOK())
+}
+
+func TestDenomParamsUpdateProposalHandler(t *testing.T) {
+	input, _ := setup(t)
+	hdlr := NewMarketPolicyUpdateHandler(input.MarketKeeper)
+
+	denomParams := NewDenomParams(core.MicroKRWDenom, sdk.NewDecWithPrec(1, 3), sdk.NewDecWithPrec(5, 2), sdk.OneDec())
+	otherDenomParams := NewDenomParams(core.MicroSDRDenom, sdk.NewDecWithPrec(1, 3), sdk.NewDecWithPrec(3, 2), sdk.OneDec())
+
+	p := types.NewDenomParamsUpdateProposal("Test", "description", denomParams, false)
+	require.NoError(t, hdlr(input.Ctx, p))
+	p = types.NewDenomParamsUpdateProposal("Test", "description", otherDenomParams, false)
+	require.NoError(t, hdlr(input.Ctx, p))
+	require.Equal(t, DenomParamsList{denomParams, otherDenomParams}, input.MarketKeeper.DenomParams(input.Ctx))
+
+	// a single entry is replaced, leaving the others
+	denomParams.MinSwapSpread = sdk.NewDecWithPrec(1, 1)
+	p = types.NewDenomParamsUpdateProposal("Test", "description", denomParams, false)
+	require.NoError(t, hdlr(input.Ctx, p))
+	require.Equal(t, denomParams, input.MarketKeeper.GetDenomSwapParams(input.Ctx, core.MicroKRWDenom))
+	require.Equal(t, DenomParamsList{denomParams, otherDenomParams}, input.MarketKeeper.DenomParams(input.Ctx))
+
+	// removed entry falls back to the global params
+	p = types.NewDenomParamsUpdateProposal("Test", "description", DenomParams{Denom: core.MicroKRWDenom}, true)
+	require.NoError(t, hdlr(input.Ctx, p))
+	require.Equal(t, DenomParamsList{otherDenomParams}, input.MarketKeeper.DenomParams(input.Ctx))
+	require.Equal(t, input.MarketKeeper.MinSwapSpread(input.Ctx), input.MarketKeeper.GetDenomSwapParams(input.Ctx, core.MicroKRWDenom).MinSwapSpread)
+}
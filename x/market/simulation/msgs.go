@@ -6,6 +6,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govsim "github.com/cosmos/cosmos-sdk/x/gov/simulation"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	core "github.com/terra-project/core/types"
@@ -32,3 +34,30 @@ func SimulateMsgPrevote(k market.Keeper) simulation.Operation {
 		return opMsg, nil, nil
 	}
 }
+
+// SimulateCircuitBreakerProposalContent generates random circuit-breaker proposal content
+func SimulateCircuitBreakerProposalContent(k market.Keeper) govsim.ContentSimulator {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) gov.Content {
+		return market.NewCircuitBreakerProposal(
+			simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 100),
+			r.Intn(2) == 0,
+		)
+	}
+}
+
+// SimulateDenomParamsUpdateProposalContent generates random denom-params-update proposal content
+func SimulateDenomParamsUpdateProposalContent(k market.Keeper) govsim.ContentSimulator {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) gov.Content {
+		denoms := []string{core.MicroSDRDenom, core.MicroKRWDenom, core.MicroUSDDenom, core.MicroGBPDenom}
+		minSwapSpread := sdk.NewDecWithPrec(r.Int63n(50), 2)
+		maxSwapSpread := minSwapSpread.Add(sdk.NewDecWithPrec(r.Int63n(51), 2))
+
+		return market.NewDenomParamsUpdateProposal(
+			simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 100),
+			market.NewDenomParams(denoms[r.Intn(len(denoms))], sdk.NewDecWithPrec(r.Int63n(100)+1, 3), minSwapSpread, maxSwapSpread),
+			r.Intn(4) == 0,
+		)
+	}
+}
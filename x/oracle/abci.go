@@ -21,9 +21,13 @@ func EndBlocker(ctx sdk.Context, k Keeper) {
 	actives := k.GetActiveDenoms(ctx)
 	votes := k.CollectVotes(ctx)
 
-	// Clear swap rates
+	// Clear swap rates and price band state of the denoms removed from the whitelist; the rates of
+	// whitelisted denoms are kept through failed tallies, and consumers check their freshness
 	for _, activeDenom := range actives {
-		k.DeletePrice(ctx, activeDenom)
+		if !params.Whitelist.Contains(activeDenom) {
+			k.DeletePrice(ctx, activeDenom)
+			k.SetUnsettledPeriods(ctx, activeDenom, 0)
+		}
 	}
 
 	ballotAttendees := make(map[string]bool)
@@ -33,41 +37,103 @@ func EndBlocker(ctx sdk.Context, k Keeper) {
 		return false
 	})
 
-	// Iterate through votes and update prices; drop if not enough votes have been achieved.
+	// Iterate through whitelisted denoms and collect ballots; validators who did not vote on
+	// every whitelisted denom missed the vote.
+	ballots := make(map[string]types.PriceBallot)
+	ballotAbstainers := make(map[string]bool)
+	for _, denom := range params.Whitelist {
+		voted := make(map[string]bool)
+		for _, vote := range votes[denom] {
+			key := vote.Voter.String()
+			voted[key] = true
+			if vote.IsAbstain() {
+				ballotAbstainers[key] = true
+				k.RecordAbstention(ctx, vote.Voter)
+			}
+		}
+		for key := range ballotAttendees {
+			if !voted[key] {
+				ballotAttendees[key] = false
+			}
+		}
+
+		// Abstains take no part in the tally and the reward
+		ballots[denom] = votes[denom].NonAbstain()
+	}
+
 	claimMap := make(map[string]types.Claim)
-	for denom, ballot := range votes {
-		if ballotIsPassing(ctx, ballot, k) {
+	applyTally := func(denom string, ballot types.PriceBallot, mod sdk.Dec, price sdk.Dec,
+		ballotWinners types.ClaimPool, ballotLosers []sdk.ValAddress) sdk.Dec {
 
-			// Get weighted median prices, and faithful respondants
-			mod, ballotWinners, ballotLosers := tally(ctx, ballot, k)
+		for _, loser := range ballotLosers {
+			key := loser.String()
+			if _, exists := ballotAttendees[key]; exists {
+				ballotAttendees[key] = false // inproper vote
+			}
+		}
 
-			for _, loser := range ballotLosers {
-				key := loser.String()
-				if _, exists := ballotAttendees[key]; exists {
-					ballotAttendees[key] = false // inproper vote
-				}
+		// Collect claims of ballot winners
+		for _, winner := range ballotWinners {
+			key := winner.Recipient.String()
+			claim, exists := claimMap[key]
+			if exists {
+				claim.Weight += winner.Weight
+				claimMap[key] = claim
+			} else {
+				claimMap[key] = winner
 			}
+		}
+
+		// Record the outcome of the ballot in the performance of its voters
+		k.RecordBallotPerformance(ctx, denom, ballot, mod, ballotWinners)
+
+		// Keep the price within the band around the last price, and set it to the store; a rejected
+		// price leaves the last price in place, still stamped with the height it was tallied at
+		price, rejected := k.ApplyPriceBand(ctx, denom, price)
+		if !rejected {
+			k.SetLunaPrice(ctx, denom, price)
+		}
+		k.RecordPriceSnapshot(ctx, denom, price)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypePriceUpdate,
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+			),
+		)
+
+		return price
+	}
+
+	// In cross-rate mode the reference denom is tallied first, and the other denoms are tallied
+	// on their cross rates to the reference; falls back to independent tallies if the reference fails.
+	referenceDenom := params.ReferenceDenom
+	referenceBallot := ballots[referenceDenom]
+	referencePrice := sdk.ZeroDec()
+	if len(referenceDenom) != 0 && k.BallotIsPassing(ctx, referenceBallot) {
+		mod, ballotWinners, ballotLosers := k.Tally(ctx, referenceBallot, k.TallyStrategy(ctx, referenceDenom))
+		referencePrice = applyTally(referenceDenom, referenceBallot, mod, mod, ballotWinners, ballotLosers)
+	}
 
-			// Collect claims of ballot winners
-			for _, winner := range ballotWinners {
-				key := winner.Recipient.String()
-				claim, exists := claimMap[key]
-				if exists {
-					claim.Weight += winner.Weight
-					claimMap[key] = claim
-				} else {
-					claimMap[key] = winner
-				}
+	// Update prices; drop if not enough votes have been achieved.
+	for _, denom := range params.Whitelist {
+		if denom == referenceDenom {
+			continue
+		}
+
+		ballot := ballots[denom]
+		if referencePrice.IsPositive() {
+			crossRateBallot := ballot.ToCrossRate(referenceBallot)
+			if k.CrossRateBallotIsPassing(ctx, crossRateBallot, referenceBallot) {
+
+				// Get weighted median cross rate, and convert it back to the price of Luna
+				mod, ballotWinners, ballotLosers := k.Tally(ctx, crossRateBallot, k.TallyStrategy(ctx, denom))
+				applyTally(denom, crossRateBallot, mod, mod.Mul(referencePrice), ballotWinners, ballotLosers)
 			}
+		} else if k.BallotIsPassing(ctx, ballot) {
 
-			// Set price to the store
-			k.SetLunaPrice(ctx, denom, mod)
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(types.EventTypePriceUpdate,
-					sdk.NewAttribute(types.AttributeKeyDenom, denom),
-					sdk.NewAttribute(types.AttributeKeyPrice, mod.String()),
-				),
-			)
+			// Get the prices of the denom's tally strategy, and faithful respondants
+			mod, ballotWinners, ballotLosers := k.Tally(ctx, ballot, k.TallyStrategy(ctx, denom))
+			applyTally(denom, ballot, mod, mod, ballotWinners, ballotLosers)
 		}
 	}
 
@@ -81,7 +147,7 @@ func EndBlocker(ctx sdk.Context, k Keeper) {
 	k.RewardBallotWinners(ctx, claimPool)
 
 	// Update & check slash condition for the ballot losers
-	k.HandleBallotSlashing(ctx, ballotAttendees)
+	k.HandleBallotSlashing(ctx, ballotAttendees, ballotAbstainers)
 
 	// Clear all prevotes
 	k.IteratePrevotes(ctx, func(prevote PricePrevote) (stop bool) {
@@ -98,5 +164,20 @@ func EndBlocker(ctx sdk.Context, k Keeper) {
 		return false
 	})
 
+	// Clear all aggregate prevotes
+	k.IterateAggregatePrevotes(ctx, func(aggregatePrevote AggregatePricePrevote) (stop bool) {
+		if ctx.BlockHeight() > aggregatePrevote.SubmitBlock+params.VotePeriod {
+			k.DeleteAggregatePrevote(ctx, aggregatePrevote)
+		}
+
+		return false
+	})
+
+	// Clear all aggregate votes
+	k.IterateAggregateVotes(ctx, func(aggregateVote AggregatePriceVote) (stop bool) {
+		k.DeleteAggregateVote(ctx, aggregateVote)
+		return false
+	})
+
 	return
 }
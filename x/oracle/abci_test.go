@@ -3,6 +3,8 @@ package oracle
 import (
 	"encoding/hex"
 	"math"
+	"strconv"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -71,22 +73,27 @@ func TestOracleThreshold(t *testing.T) {
 	salt = "1"
 	bz, err = VoteHash(salt, randomPrice, core.MicroSDRDenom, keeper.ValAddrs[0])
 	prevoteMsg = NewMsgPricePrevote(hex.EncodeToString(bz), core.MicroSDRDenom, keeper.Addrs[0], keeper.ValAddrs[0])
-	h(input.Ctx.WithBlockHeight(0), prevoteMsg)
+	h(input.Ctx.WithBlockHeight(1), prevoteMsg)
 
 	voteMsg = NewMsgPriceVote(randomPrice, salt, core.MicroSDRDenom, keeper.Addrs[0], keeper.ValAddrs[0])
-	h(input.Ctx.WithBlockHeight(1), voteMsg)
+	h(input.Ctx.WithBlockHeight(2), voteMsg)
 
 	salt = "2"
 	bz, err = VoteHash(salt, randomPrice, core.MicroSDRDenom, keeper.ValAddrs[1])
 	prevoteMsg = NewMsgPricePrevote(hex.EncodeToString(bz), core.MicroSDRDenom, keeper.Addrs[1], keeper.ValAddrs[1])
-	h(input.Ctx.WithBlockHeight(0), prevoteMsg)
+	h(input.Ctx.WithBlockHeight(1), prevoteMsg)
 
 	voteMsg = NewMsgPriceVote(randomPrice, salt, core.MicroSDRDenom, keeper.Addrs[1], keeper.ValAddrs[1])
-	h(input.Ctx.WithBlockHeight(1), voteMsg)
+	h(input.Ctx.WithBlockHeight(2), voteMsg)
 
-	EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+	EndBlocker(input.Ctx.WithBlockHeight(2), input.OracleKeeper)
 
-	price, err = input.OracleKeeper.GetLunaPrice(input.Ctx.WithBlockHeight(1), core.MicroSDRDenom)
+	// The price of the last passing tally is kept, and goes stale
+	price, err = input.OracleKeeper.GetLunaPrice(input.Ctx.WithBlockHeight(3), core.MicroSDRDenom)
+	require.Nil(t, err)
+	require.Equal(t, randomPrice, price)
+
+	_, err = input.OracleKeeper.GetFreshLunaPrice(input.Ctx.WithBlockHeight(3), core.MicroSDRDenom, 1)
 	require.NotNil(t, err)
 }
 
@@ -160,10 +167,10 @@ func TestOracleDrop(t *testing.T) {
 	voteMsg := NewMsgPriceVote(randomPrice, salt, core.MicroKRWDenom, keeper.Addrs[0], keeper.ValAddrs[0])
 	h(input.Ctx, voteMsg)
 
-	// Immediately swap halt after an illiquid oracle vote
+	// Immediately swap halt after an illiquid oracle vote; the price is stale from the next block
 	EndBlocker(input.Ctx, input.OracleKeeper)
 
-	_, err = input.OracleKeeper.GetLunaPrice(input.Ctx, core.MicroKRWDenom)
+	_, err = input.OracleKeeper.GetFreshLunaPrice(input.Ctx.WithBlockHeight(2), core.MicroKRWDenom, 1)
 	require.NotNil(t, err)
 }
 
@@ -178,7 +185,7 @@ func TestOracleTally(t *testing.T) {
 
 		decPrice := sdk.NewDecWithPrec(int64(price*math.Pow10(keeper.OracleDecPrecision)), int64(keeper.OracleDecPrecision))
 
-		salt := string(i)
+		salt := strconv.Itoa(i)
 		bz, err := VoteHash(salt, decPrice, core.MicroSDRDenom, valAddrs[i])
 		require.Nil(t, err)
 
@@ -227,7 +234,7 @@ func TestOracleTally(t *testing.T) {
 		}
 	}
 
-	tallyMedian, ballotWinner, _ := tally(input.Ctx, ballot, input.OracleKeeper)
+	tallyMedian, ballotWinner, _ := input.OracleKeeper.Tally(input.Ctx, ballot, types.WeightedMedianStrategy{})
 
 	require.Equal(t, len(rewardees), len(ballotWinner))
 	require.Equal(t, tallyMedian.MulInt64(100).TruncateInt(), weightedMedian.MulInt64(100).TruncateInt())
@@ -370,3 +377,487 @@ func TestOracleMultiRewardDistribution(t *testing.T) {
 	rewards = input.DistrKeeper.GetValidatorOutstandingRewards(input.Ctx.WithBlockHeight(2), keeper.ValAddrs[2])
 	require.Equal(t, expectedRewardAmt2, rewards.AmountOf(core.MicroSDRDenom).TruncateInt())
 }
+
+func TestOracleAggregateVote(t *testing.T) {
+	input, h := setup(t)
+
+	krwPrice := sdk.NewDec(3000)
+	prices := NewPriceTuple(core.MicroSDRDenom, randomPrice).String() + "," + NewPriceTuple(core.MicroKRWDenom, krwPrice).String()
+
+	// Validator 0 and 1 vote on all denoms with aggregate messages
+	for i, salt := range []string{"1", "2"} {
+		bz, err := AggregateVoteHash(salt, prices, keeper.ValAddrs[i])
+		require.Nil(t, err)
+
+		prevoteMsg := NewMsgAggregatePricePrevote(hex.EncodeToString(bz), keeper.Addrs[i], keeper.ValAddrs[i])
+		res := h(input.Ctx.WithBlockHeight(0), prevoteMsg)
+		require.True(t, res.IsOK())
+
+		voteMsg := NewMsgAggregatePriceVote(salt, prices, keeper.Addrs[i], keeper.ValAddrs[i])
+		res = h(input.Ctx.WithBlockHeight(1), voteMsg)
+		require.True(t, res.IsOK())
+	}
+
+	// Validator 2 keeps voting with legacy per-denom messages
+	salt := "3"
+	bz, err := VoteHash(salt, randomPrice, core.MicroSDRDenom, keeper.ValAddrs[2])
+	require.Nil(t, err)
+	prevoteMsg := NewMsgPricePrevote(hex.EncodeToString(bz), core.MicroSDRDenom, keeper.Addrs[2], keeper.ValAddrs[2])
+	res := h(input.Ctx.WithBlockHeight(0), prevoteMsg)
+	require.True(t, res.IsOK())
+
+	voteMsg := NewMsgPriceVote(randomPrice, salt, core.MicroSDRDenom, keeper.Addrs[2], keeper.ValAddrs[2])
+	res = h(input.Ctx.WithBlockHeight(1), voteMsg)
+	require.True(t, res.IsOK())
+
+	EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+
+	price, err := input.OracleKeeper.GetLunaPrice(input.Ctx.WithBlockHeight(1), core.MicroSDRDenom)
+	require.Nil(t, err)
+	require.Equal(t, randomPrice, price)
+
+	price, err = input.OracleKeeper.GetLunaPrice(input.Ctx.WithBlockHeight(1), core.MicroKRWDenom)
+	require.Nil(t, err)
+	require.Equal(t, krwPrice, price)
+
+	// Tallied prices are kept in the price history
+	price, err = input.OracleKeeper.GetHistoricalLunaPrice(input.Ctx.WithBlockHeight(1), core.MicroKRWDenom, 1)
+	require.Nil(t, err)
+	require.Equal(t, krwPrice, price)
+
+	// Aggregate votes are cleared after the tally
+	for i := 0; i < 2; i++ {
+		_, err = input.OracleKeeper.GetAggregateVote(input.Ctx, keeper.ValAddrs[i])
+		require.Error(t, err)
+	}
+}
+
+func TestOracleWhitelistMiss(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = DenomList{core.MicroSDRDenom, core.MicroKRWDenom}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Validator 0 and 1 vote on every whitelisted denom, validator 2 only on SDR
+	prices := NewPriceTuple(core.MicroSDRDenom, randomPrice).String() + "," + NewPriceTuple(core.MicroKRWDenom, randomPrice).String()
+	for i, salt := range []string{"1", "2"} {
+		bz, err := AggregateVoteHash(salt, prices, keeper.ValAddrs[i])
+		require.Nil(t, err)
+		res := h(input.Ctx.WithBlockHeight(0), NewMsgAggregatePricePrevote(hex.EncodeToString(bz), keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.True(t, res.IsOK())
+		res = h(input.Ctx.WithBlockHeight(1), NewMsgAggregatePriceVote(salt, prices, keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.True(t, res.IsOK())
+	}
+
+	salt := "3"
+	bz, err := VoteHash(salt, randomPrice, core.MicroSDRDenom, keeper.ValAddrs[2])
+	require.Nil(t, err)
+	res := h(input.Ctx.WithBlockHeight(0), NewMsgPricePrevote(hex.EncodeToString(bz), core.MicroSDRDenom, keeper.Addrs[2], keeper.ValAddrs[2]))
+	require.True(t, res.IsOK())
+	res = h(input.Ctx.WithBlockHeight(1), NewMsgPriceVote(randomPrice, salt, core.MicroSDRDenom, keeper.Addrs[2], keeper.ValAddrs[2]))
+	require.True(t, res.IsOK())
+
+	EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+
+	require.False(t, input.OracleKeeper.GetMissedVoteBitArray(input.Ctx, keeper.ValAddrs[0], 0))
+	require.False(t, input.OracleKeeper.GetMissedVoteBitArray(input.Ctx, keeper.ValAddrs[1], 0))
+	require.True(t, input.OracleKeeper.GetMissedVoteBitArray(input.Ctx, keeper.ValAddrs[2], 0))
+}
+
+func TestOracleDefaultWhitelist(t *testing.T) {
+	input, h := setup(t)
+	params := DefaultGenesisState().Params
+	params.VotePeriod = 1
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Every Terra denom the oracle tallied before the whitelist is still tallied by default
+	denoms := []string{core.MicroKRWDenom, core.MicroSDRDenom, core.MicroUSDDenom, core.MicroCNYDenom,
+		core.MicroJPYDenom, core.MicroEURDenom, core.MicroGBPDenom}
+
+	var tuples []string
+	for _, denom := range denoms {
+		tuples = append(tuples, NewPriceTuple(denom, randomPrice).String())
+	}
+	prices := strings.Join(tuples, ",")
+
+	for i := 0; i < 3; i++ {
+		salt := strconv.Itoa(i)
+		bz, err := AggregateVoteHash(salt, prices, keeper.ValAddrs[i])
+		require.Nil(t, err)
+		res := h(input.Ctx.WithBlockHeight(0), NewMsgAggregatePricePrevote(hex.EncodeToString(bz), keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.True(t, res.IsOK())
+		res = h(input.Ctx.WithBlockHeight(1), NewMsgAggregatePriceVote(salt, prices, keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.True(t, res.IsOK())
+	}
+
+	EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+
+	for _, denom := range denoms {
+		price, err := input.OracleKeeper.GetLunaPrice(input.Ctx, denom)
+		require.NoError(t, err, denom)
+		require.Equal(t, randomPrice, price, denom)
+	}
+}
+
+func TestOracleAbstain(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = DenomList{core.MicroSDRDenom}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Validator 0 and 1 report a price, validator 2 abstains
+	for i, price := range []sdk.Dec{randomPrice, randomPrice, sdk.ZeroDec()} {
+		salt := strconv.Itoa(i)
+		bz, err := VoteHash(salt, price, core.MicroSDRDenom, keeper.ValAddrs[i])
+		require.Nil(t, err)
+		res := h(input.Ctx.WithBlockHeight(0), NewMsgPricePrevote(hex.EncodeToString(bz), core.MicroSDRDenom, keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.True(t, res.IsOK())
+		res = h(input.Ctx.WithBlockHeight(1), NewMsgPriceVote(price, salt, core.MicroSDRDenom, keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.True(t, res.IsOK())
+	}
+
+	moduleAcc := input.SupplyKeeper.GetModuleAccount(input.Ctx.WithBlockHeight(1), ModuleName)
+	err := moduleAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, stakingAmt.MulRaw(100))))
+	require.NoError(t, err)
+	input.SupplyKeeper.SetModuleAccount(input.Ctx.WithBlockHeight(1), moduleAcc)
+
+	EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+
+	// Abstain takes no part in the median
+	price, err := input.OracleKeeper.GetLunaPrice(input.Ctx, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomPrice, price)
+
+	// Abstain is no miss, but earns no reward
+	require.False(t, input.OracleKeeper.GetMissedVoteBitArray(input.Ctx, keeper.ValAddrs[2], 0))
+	rewards := input.DistrKeeper.GetValidatorOutstandingRewards(input.Ctx.WithBlockHeight(2), keeper.ValAddrs[2])
+	require.True(t, rewards.AmountOf(core.MicroSDRDenom).IsZero())
+
+	expectedRewardAmt := input.OracleKeeper.RewardFraction(input.Ctx).MulInt(stakingAmt.MulRaw(50)).TruncateInt()
+	rewards = input.DistrKeeper.GetValidatorOutstandingRewards(input.Ctx.WithBlockHeight(2), keeper.ValAddrs[0])
+	require.Equal(t, expectedRewardAmt, rewards.AmountOf(core.MicroSDRDenom).TruncateInt())
+}
+
+func TestOracleCrossRateTally(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = DenomList{core.MicroSDRDenom, core.MicroKRWDenom}
+	params.ReferenceDenom = core.MicroSDRDenom
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Validator 0 and 1 vote on the reference, only validator 0 on KRW; validator 2 abstains on both
+	krwPrice := randomPrice.MulInt64(2)
+	votes := []string{
+		NewPriceTuple(core.MicroSDRDenom, randomPrice).String() + "," + NewPriceTuple(core.MicroKRWDenom, krwPrice).String(),
+		NewPriceTuple(core.MicroSDRDenom, randomPrice).String() + "," + NewPriceTuple(core.MicroKRWDenom, sdk.ZeroDec()).String(),
+		NewPriceTuple(core.MicroSDRDenom, sdk.ZeroDec()).String() + "," + NewPriceTuple(core.MicroKRWDenom, sdk.ZeroDec()).String(),
+	}
+	for i, prices := range votes {
+		salt := strconv.Itoa(i)
+		bz, err := AggregateVoteHash(salt, prices, keeper.ValAddrs[i])
+		require.Nil(t, err)
+		res := h(input.Ctx.WithBlockHeight(0), NewMsgAggregatePricePrevote(hex.EncodeToString(bz), keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.True(t, res.IsOK())
+		res = h(input.Ctx.WithBlockHeight(1), NewMsgAggregatePriceVote(salt, prices, keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.True(t, res.IsOK())
+	}
+
+	// KRW ballot holds a third of the bonded power, but half of the reference ballot power
+	EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+
+	price, err := input.OracleKeeper.GetLunaPrice(input.Ctx, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomPrice, price)
+
+	price, err = input.OracleKeeper.GetLunaPrice(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, krwPrice, price)
+
+	// The same ballots tallied independently drop KRW
+	params.ReferenceDenom = ""
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	for i, prices := range votes {
+		salt := strconv.Itoa(i)
+		bz, err := AggregateVoteHash(salt, prices, keeper.ValAddrs[i])
+		require.Nil(t, err)
+		res := h(input.Ctx.WithBlockHeight(1), NewMsgAggregatePricePrevote(hex.EncodeToString(bz), keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.True(t, res.IsOK())
+		res = h(input.Ctx.WithBlockHeight(2), NewMsgAggregatePriceVote(salt, prices, keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.True(t, res.IsOK())
+	}
+
+	EndBlocker(input.Ctx.WithBlockHeight(2), input.OracleKeeper)
+
+	price, err = input.OracleKeeper.GetFreshLunaPrice(input.Ctx.WithBlockHeight(3), core.MicroSDRDenom, 1)
+	require.NoError(t, err)
+	require.Equal(t, randomPrice, price)
+
+	_, err = input.OracleKeeper.GetFreshLunaPrice(input.Ctx.WithBlockHeight(3), core.MicroKRWDenom, 1)
+	require.Error(t, err)
+}
+
+func TestOracleCrossRateReferenceFails(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = DenomList{core.MicroSDRDenom, core.MicroKRWDenom}
+	params.ReferenceDenom = core.MicroSDRDenom
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Only validator 0 votes on the reference, every validator on KRW
+	for i := 0; i < 3; i++ {
+		sdrPrice := sdk.ZeroDec()
+		if i == 0 {
+			sdrPrice = randomPrice
+		}
+
+		prices := NewPriceTuple(core.MicroSDRDenom, sdrPrice).String() + "," + NewPriceTuple(core.MicroKRWDenom, anotherRandomPrice).String()
+		salt := strconv.Itoa(i)
+		bz, err := AggregateVoteHash(salt, prices, keeper.ValAddrs[i])
+		require.Nil(t, err)
+		res := h(input.Ctx.WithBlockHeight(0), NewMsgAggregatePricePrevote(hex.EncodeToString(bz), keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.True(t, res.IsOK())
+		res = h(input.Ctx.WithBlockHeight(1), NewMsgAggregatePriceVote(salt, prices, keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.True(t, res.IsOK())
+	}
+
+	EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+
+	// Reference ballot fails, KRW falls back to the independent tally
+	_, err := input.OracleKeeper.GetLunaPrice(input.Ctx, core.MicroSDRDenom)
+	require.Error(t, err)
+
+	price, err := input.OracleKeeper.GetLunaPrice(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, anotherRandomPrice, price)
+}
+
+func TestOracleTallyStrategy(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = DenomList{core.MicroSDRDenom, core.MicroKRWDenom}
+	params.TallyStrategies = DenomTallyStrategies{NewDenomTallyStrategy(core.MicroSDRDenom, TallyStrategyTrimmedMean, sdk.ZeroDec())}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Equal voting power; the mean of the votes differs from their median
+	prices := []sdk.Dec{randomPrice, randomPrice, randomPrice.MulInt64(4)}
+	for i, price := range prices {
+		votes := NewPriceTuple(core.MicroSDRDenom, price).String() + "," + NewPriceTuple(core.MicroKRWDenom, price).String()
+		salt := strconv.Itoa(i)
+		bz, err := AggregateVoteHash(salt, votes, keeper.ValAddrs[i])
+		require.Nil(t, err)
+		res := h(input.Ctx.WithBlockHeight(0), NewMsgAggregatePricePrevote(hex.EncodeToString(bz), keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.True(t, res.IsOK())
+		res = h(input.Ctx.WithBlockHeight(1), NewMsgAggregatePriceVote(salt, votes, keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.True(t, res.IsOK())
+	}
+
+	EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+
+	// SDR is tallied on the mean, KRW on the default weighted median
+	price, err := input.OracleKeeper.GetLunaPrice(input.Ctx, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomPrice.MulInt64(2), price)
+
+	price, err = input.OracleKeeper.GetLunaPrice(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomPrice, price)
+}
+
+func TestOraclePriceBand(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = DenomList{core.MicroSDRDenom}
+	params.MaxPriceChange = sdk.NewDecWithPrec(1, 1)
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	vote := func(height int64, price sdk.Dec) {
+		for i := 0; i < 3; i++ {
+			prices := NewPriceTuple(core.MicroSDRDenom, price).String()
+			salt := strconv.Itoa(i)
+			bz, err := AggregateVoteHash(salt, prices, keeper.ValAddrs[i])
+			require.Nil(t, err)
+			res := h(input.Ctx.WithBlockHeight(height-1), NewMsgAggregatePricePrevote(hex.EncodeToString(bz), keeper.Addrs[i], keeper.ValAddrs[i]))
+			require.True(t, res.IsOK())
+			res = h(input.Ctx.WithBlockHeight(height), NewMsgAggregatePriceVote(salt, prices, keeper.Addrs[i], keeper.ValAddrs[i]))
+			require.True(t, res.IsOK())
+		}
+
+		EndBlocker(input.Ctx.WithBlockHeight(height), input.OracleKeeper)
+	}
+
+	// The first price is not banded
+	vote(1, randomPrice)
+	price, err := input.OracleKeeper.GetLunaPrice(input.Ctx, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomPrice, price)
+	require.False(t, input.OracleKeeper.IsPriceUnsettled(input.Ctx, core.MicroSDRDenom))
+
+	// A 50% drop is clamped to 10%, and leaves the price unsettled
+	vote(2, randomPrice.QuoInt64(2))
+	price, err = input.OracleKeeper.GetLunaPrice(input.Ctx, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomPrice.Mul(sdk.NewDecWithPrec(9, 1)), price)
+	require.True(t, input.OracleKeeper.IsPriceUnsettled(input.Ctx, core.MicroSDRDenom))
+
+	// A tally within the band of the clamped price settles it
+	vote(3, randomPrice.Mul(sdk.NewDecWithPrec(85, 2)))
+	price, err = input.OracleKeeper.GetLunaPrice(input.Ctx, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomPrice.Mul(sdk.NewDecWithPrec(85, 2)), price)
+	require.False(t, input.OracleKeeper.IsPriceUnsettled(input.Ctx, core.MicroSDRDenom))
+
+	// In reject mode the last price is kept, along with the height it was tallied at
+	params.PriceBandMode = PriceBandModeReject
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	vote(4, randomPrice.MulInt64(2))
+	info, err := input.OracleKeeper.GetLunaPriceInfo(input.Ctx, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomPrice.Mul(sdk.NewDecWithPrec(85, 2)), info.Price)
+	require.Equal(t, int64(3), info.Height)
+	require.True(t, input.OracleKeeper.IsPriceUnsettled(input.Ctx, core.MicroSDRDenom))
+
+	_, err = input.OracleKeeper.GetFreshLunaPrice(input.Ctx.WithBlockHeight(5), core.MicroSDRDenom, 1)
+	require.Error(t, err)
+}
+
+func TestOracleWhitelistRemovalSettlesPrice(t *testing.T) {
+	input, _ := setup(t)
+
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroKRWDenom, randomPrice)
+	input.OracleKeeper.SetUnsettledPeriods(input.Ctx, core.MicroKRWDenom, 2)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = DenomList{core.MicroSDRDenom}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// The denom removed from the whitelist loses its price and its unsettled state
+	EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+
+	_, err := input.OracleKeeper.GetLunaPrice(input.Ctx, core.MicroKRWDenom)
+	require.Error(t, err)
+	require.False(t, input.OracleKeeper.IsPriceUnsettled(input.Ctx, core.MicroKRWDenom))
+}
+
+func TestOracleStalePrice(t *testing.T) {
+	input, _ := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = DenomList{core.MicroSDRDenom}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, randomPrice)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroKRWDenom, randomPrice)
+
+	// Failed tallies keep the whitelisted price with its tally height, and clear the others
+	EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+
+	info, err := input.OracleKeeper.GetLunaPriceInfo(input.Ctx.WithBlockHeight(2), core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, randomPrice, info.Price)
+	require.Equal(t, input.Ctx.BlockHeight(), info.Height)
+
+	_, err = input.OracleKeeper.GetFreshLunaPrice(input.Ctx.WithBlockHeight(2), core.MicroSDRDenom, 1)
+	require.Error(t, err)
+
+	_, err = input.OracleKeeper.GetFreshLunaPrice(input.Ctx.WithBlockHeight(2), core.MicroSDRDenom, 2)
+	require.NoError(t, err)
+
+	_, err = input.OracleKeeper.GetLunaPrice(input.Ctx.WithBlockHeight(2), core.MicroKRWDenom)
+	require.Error(t, err)
+}
+
+func TestOraclePerformance(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = DenomList{core.MicroSDRDenom, core.MicroKRWDenom}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Validator 2 votes far off the median on SDR, and abstains on KRW
+	sdrPrices := []sdk.Dec{randomPrice, randomPrice, randomPrice.MulInt64(4)}
+	krwPrices := []sdk.Dec{randomPrice, randomPrice, sdk.ZeroDec()}
+	for i := range sdrPrices {
+		votes := NewPriceTuple(core.MicroSDRDenom, sdrPrices[i]).String() + "," + NewPriceTuple(core.MicroKRWDenom, krwPrices[i]).String()
+		salt := strconv.Itoa(i)
+		bz, err := AggregateVoteHash(salt, votes, keeper.ValAddrs[i])
+		require.Nil(t, err)
+		res := h(input.Ctx.WithBlockHeight(0), NewMsgAggregatePricePrevote(hex.EncodeToString(bz), keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.True(t, res.IsOK())
+		res = h(input.Ctx.WithBlockHeight(1), NewMsgAggregatePriceVote(salt, votes, keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.True(t, res.IsOK())
+	}
+
+	moduleAcc := input.SupplyKeeper.GetModuleAccount(input.Ctx.WithBlockHeight(1), ModuleName)
+	err := moduleAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, stakingAmt.MulRaw(100))))
+	require.NoError(t, err)
+	input.SupplyKeeper.SetModuleAccount(input.Ctx.WithBlockHeight(1), moduleAcc)
+
+	EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+
+	performance, found := input.OracleKeeper.GetValidatorPerformance(input.Ctx, keeper.ValAddrs[0])
+	require.True(t, found)
+	require.Equal(t, int64(2), performance.BallotsWon)
+	require.Equal(t, int64(0), performance.BallotsLost)
+	require.Equal(t, int64(0), performance.Abstentions)
+	require.Equal(t, []DenomDeviation{
+		NewDenomDeviation(core.MicroSDRDenom, 1, sdk.ZeroDec()),
+		NewDenomDeviation(core.MicroKRWDenom, 1, sdk.ZeroDec()),
+	}, performance.Deviations)
+	require.False(t, performance.Rewards.Empty())
+
+	performance, found = input.OracleKeeper.GetValidatorPerformance(input.Ctx, keeper.ValAddrs[2])
+	require.True(t, found)
+	require.Equal(t, int64(0), performance.BallotsWon)
+	require.Equal(t, int64(1), performance.BallotsLost)
+	require.Equal(t, int64(1), performance.Abstentions)
+	require.Equal(t, []DenomDeviation{NewDenomDeviation(core.MicroSDRDenom, 1, randomPrice.MulInt64(3))}, performance.Deviations)
+	require.True(t, performance.Rewards.Empty())
+}
+
+func TestOracleCrossRatePerformance(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = DenomList{core.MicroSDRDenom, core.MicroKRWDenom}
+	params.ReferenceDenom = core.MicroSDRDenom
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Validator 2 votes a KRW cross rate of 3 against the tallied cross rate of 2
+	krwPrices := []sdk.Dec{randomPrice.MulInt64(2), randomPrice.MulInt64(2), randomPrice.MulInt64(3)}
+	for i := range krwPrices {
+		votes := NewPriceTuple(core.MicroSDRDenom, randomPrice).String() + "," + NewPriceTuple(core.MicroKRWDenom, krwPrices[i]).String()
+		salt := strconv.Itoa(i)
+		bz, err := AggregateVoteHash(salt, votes, keeper.ValAddrs[i])
+		require.Nil(t, err)
+		res := h(input.Ctx.WithBlockHeight(0), NewMsgAggregatePricePrevote(hex.EncodeToString(bz), keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.True(t, res.IsOK())
+		res = h(input.Ctx.WithBlockHeight(1), NewMsgAggregatePriceVote(salt, votes, keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.True(t, res.IsOK())
+	}
+
+	EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+
+	// Deviations on KRW are measured on the tallied cross rate ballot
+	performance, found := input.OracleKeeper.GetValidatorPerformance(input.Ctx, keeper.ValAddrs[0])
+	require.True(t, found)
+	require.Equal(t, int64(2), performance.BallotsWon)
+	require.Equal(t, []DenomDeviation{
+		NewDenomDeviation(core.MicroSDRDenom, 1, sdk.ZeroDec()),
+		NewDenomDeviation(core.MicroKRWDenom, 1, sdk.ZeroDec()),
+	}, performance.Deviations)
+
+	performance, found = input.OracleKeeper.GetValidatorPerformance(input.Ctx, keeper.ValAddrs[2])
+	require.True(t, found)
+	require.Equal(t, int64(1), performance.BallotsWon)
+	require.Equal(t, int64(1), performance.BallotsLost)
+	require.Equal(t, []DenomDeviation{
+		NewDenomDeviation(core.MicroSDRDenom, 1, sdk.ZeroDec()),
+		NewDenomDeviation(core.MicroKRWDenom, 1, sdk.OneDec()),
+	}, performance.Deviations)
+}
@@ -11,80 +11,143 @@ import (
 )
 
 const (
-	DefaultCodespace       = types.DefaultCodespace
-	CodeUnknownDenom       = types.CodeUnknownDenom
-	CodeInvalidPrice       = types.CodeInvalidPrice
-	CodeVoterNotValidator  = types.CodeVoterNotValidator
-	CodeInvalidVote        = types.CodeInvalidVote
-	CodeNoVotingPermission = types.CodeNoVotingPermission
-	CodeInvalidHashLength  = types.CodeInvalidHashLength
-	CodeInvalidPrevote     = types.CodeInvalidPrevote
-	CodeVerificationFailed = types.CodeVerificationFailed
-	CodeNotRevealPeriod    = types.CodeNotRevealPeriod
-	CodeInvalidSaltLength  = types.CodeInvalidSaltLength
-	CodeInvalidMsgFormat   = types.CodeInvalidMsgFormat
-	CodeMissingVotingInfo  = types.CodeMissingVotingInfo
-	ModuleName             = types.ModuleName
-	StoreKey               = types.StoreKey
-	RouterKey              = types.RouterKey
-	QuerierRoute           = types.QuerierRoute
-	DefaultParamspace      = types.DefaultParamspace
-	DefaultVotePeriod      = types.DefaultVotePeriod
-	DefaultVotesWindow     = types.DefaultVotesWindow
-	QueryParameters        = types.QueryParameters
-	QueryPrice             = types.QueryPrice
-	QueryActives           = types.QueryActives
-	QueryPrevotes          = types.QueryPrevotes
-	QueryVotes             = types.QueryVotes
-	QueryFeederDelegation  = types.QueryFeederDelegation
-	QueryVotingInfo        = types.QueryVotingInfo
-	QueryVotingInfos       = types.QueryVotingInfos
+	DefaultCodespace                 = types.DefaultCodespace
+	CodeUnknownDenom                 = types.CodeUnknownDenom
+	CodeInvalidPrice                 = types.CodeInvalidPrice
+	CodeVoterNotValidator            = types.CodeVoterNotValidator
+	CodeInvalidVote                  = types.CodeInvalidVote
+	CodeNoVotingPermission           = types.CodeNoVotingPermission
+	CodeInvalidHashLength            = types.CodeInvalidHashLength
+	CodeInvalidPrevote               = types.CodeInvalidPrevote
+	CodeVerificationFailed           = types.CodeVerificationFailed
+	CodeNotRevealPeriod              = types.CodeNotRevealPeriod
+	CodeInvalidSaltLength            = types.CodeInvalidSaltLength
+	CodeInvalidMsgFormat             = types.CodeInvalidMsgFormat
+	CodeMissingVotingInfo            = types.CodeMissingVotingInfo
+	CodeNoAggregatePrevote           = types.CodeNoAggregatePrevote
+	CodeNoAggregateVote              = types.CodeNoAggregateVote
+	CodeNoPriceHistory               = types.CodeNoPriceHistory
+	CodeValidatorNotJailed           = types.CodeValidatorNotJailed
+	CodeValidatorJailed              = types.CodeValidatorJailed
+	CodeMissingSelfDelegation        = types.CodeMissingSelfDelegation
+	CodeSelfDelegationTooLowToUnjail = types.CodeSelfDelegationTooLowToUnjail
+	CodeStalePrice                   = types.CodeStalePrice
+	CodeNoPerformance                = types.CodeNoPerformance
+	CodeValidatorTombstoned          = types.CodeValidatorTombstoned
+	ModuleName                       = types.ModuleName
+	StoreKey                         = types.StoreKey
+	RouterKey                        = types.RouterKey
+	QuerierRoute                     = types.QuerierRoute
+	DefaultParamspace                = types.DefaultParamspace
+	DefaultVotePeriod                = types.DefaultVotePeriod
+	DefaultVotesWindow               = types.DefaultVotesWindow
+	DefaultPriceHistoryRetention     = types.DefaultPriceHistoryRetention
+	DefaultReferenceDenom            = types.DefaultReferenceDenom
+	DefaultJailDuration              = types.DefaultJailDuration
+	DefaultPriceBandMode             = types.DefaultPriceBandMode
+	DefaultMaxUnsettledPeriods       = types.DefaultMaxUnsettledPeriods
+	QueryParameters                  = types.QueryParameters
+	QueryPrice                       = types.QueryPrice
+	QueryActives                     = types.QueryActives
+	QueryPrevotes                    = types.QueryPrevotes
+	QueryVotes                       = types.QueryVotes
+	QueryFeederDelegation            = types.QueryFeederDelegation
+	QueryVotingInfo                  = types.QueryVotingInfo
+	QueryVotingInfos                 = types.QueryVotingInfos
+	QueryAggregatePrevote            = types.QueryAggregatePrevote
+	QueryAggregateVote               = types.QueryAggregateVote
+	QueryHistoricalPrice             = types.QueryHistoricalPrice
+	QueryTWAP                        = types.QueryTWAP
+	QueryPerformance                 = types.QueryPerformance
+	QueryPerformances                = types.QueryPerformances
+	QueryBallotPreview               = types.QueryBallotPreview
+	TallyStrategyWeightedMedian      = types.TallyStrategyWeightedMedian
+	TallyStrategyTrimmedMean         = types.TallyStrategyTrimmedMean
+	TallyStrategyFilteredMedian      = types.TallyStrategyFilteredMedian
+	PriceBandModeClamp               = types.PriceBandModeClamp
+	PriceBandModeReject              = types.PriceBandModeReject
 )
 
 var (
 	// functions aliases
-	NewClaim                       = types.NewClaim
-	RegisterCodec                  = types.RegisterCodec
-	ErrInvalidHashLength           = types.ErrInvalidHashLength
-	ErrUnknownDenomination         = types.ErrUnknownDenomination
-	ErrInvalidPrice                = types.ErrInvalidPrice
-	ErrVoterNotValidator           = types.ErrVoterNotValidator
-	ErrVerificationFailed          = types.ErrVerificationFailed
-	ErrNoPrevote                   = types.ErrNoPrevote
-	ErrNoVote                      = types.ErrNoVote
-	ErrNoVotingPermission          = types.ErrNoVotingPermission
-	ErrNotRevealPeriod             = types.ErrNotRevealPeriod
-	ErrInvalidSaltLength           = types.ErrInvalidSaltLength
-	ErrInvalidMsgFormat            = types.ErrInvalidMsgFormat
-	ErrNoVotingInfoFound           = types.ErrNoVotingInfoFound
-	NewGenesisState                = types.NewGenesisState
-	NewMissedVote                  = types.NewMissedVote
-	DefaultGenesisState            = types.DefaultGenesisState
-	ValidateGenesis                = types.ValidateGenesis
-	GetPrevoteKey                  = types.GetPrevoteKey
-	GetVoteKey                     = types.GetVoteKey
-	GetPriceKey                    = types.GetPriceKey
-	GetFeederDelegationKey         = types.GetFeederDelegationKey
-	GetMissedVoteBitArrayPrefixKey = types.GetMissedVoteBitArrayPrefixKey
-	GetMissedVoteBitArrayKey       = types.GetMissedVoteBitArrayKey
-	GetVotingInfoKey               = types.GetVotingInfoKey
-	NewMsgPricePrevote             = types.NewMsgPricePrevote
-	NewMsgPriceVote                = types.NewMsgPriceVote
-	NewMsgDelegateFeederPermission = types.NewMsgDelegateFeederPermission
-	DefaultParams                  = types.DefaultParams
-	NewQueryPriceParams            = types.NewQueryPriceParams
-	NewQueryPrevotesParams         = types.NewQueryPrevotesParams
-	NewQueryVotesParams            = types.NewQueryVotesParams
-	NewQueryFeederDelegationParams = types.NewQueryFeederDelegationParams
-	NewQueryVotingInfoParams       = types.NewQueryVotingInfoParams
-	NewQueryVotingInfosParams      = types.NewQueryVotingInfosParams
-	NewPricePrevote                = types.NewPricePrevote
-	VoteHash                       = types.VoteHash
-	NewPriceVote                   = types.NewPriceVote
-	NewVotingInfo                  = types.NewVotingInfo
-	NewKeeper                      = keeper.NewKeeper
-	ParamKeyTable                  = keeper.ParamKeyTable
-	NewQuerier                     = keeper.NewQuerier
+	NewClaim                        = types.NewClaim
+	RegisterCodec                   = types.RegisterCodec
+	ErrInvalidHashLength            = types.ErrInvalidHashLength
+	ErrUnknownDenomination          = types.ErrUnknownDenomination
+	ErrInvalidPrice                 = types.ErrInvalidPrice
+	ErrVoterNotValidator            = types.ErrVoterNotValidator
+	ErrVerificationFailed           = types.ErrVerificationFailed
+	ErrNoPrevote                    = types.ErrNoPrevote
+	ErrNoVote                       = types.ErrNoVote
+	ErrNoVotingPermission           = types.ErrNoVotingPermission
+	ErrNotRevealPeriod              = types.ErrNotRevealPeriod
+	ErrInvalidSaltLength            = types.ErrInvalidSaltLength
+	ErrInvalidMsgFormat             = types.ErrInvalidMsgFormat
+	ErrNoVotingInfoFound            = types.ErrNoVotingInfoFound
+	ErrNoAggregatePrevote           = types.ErrNoAggregatePrevote
+	ErrNoAggregateVote              = types.ErrNoAggregateVote
+	ErrNoPriceHistory               = types.ErrNoPriceHistory
+	ErrValidatorNotJailed           = types.ErrValidatorNotJailed
+	ErrValidatorJailed              = types.ErrValidatorJailed
+	ErrMissingSelfDelegation        = types.ErrMissingSelfDelegation
+	ErrSelfDelegationTooLowToUnjail = types.ErrSelfDelegationTooLowToUnjail
+	ErrStalePrice                   = types.ErrStalePrice
+	ErrNoPerformanceFound           = types.ErrNoPerformanceFound
+	ErrValidatorTombstoned          = types.ErrValidatorTombstoned
+	NewGenesisState                 = types.NewGenesisState
+	NewMissedVote                   = types.NewMissedVote
+	DefaultGenesisState             = types.DefaultGenesisState
+	ValidateGenesis                 = types.ValidateGenesis
+	GetPrevoteKey                   = types.GetPrevoteKey
+	GetVoteKey                      = types.GetVoteKey
+	GetPriceKey                     = types.GetPriceKey
+	GetFeederDelegationKey          = types.GetFeederDelegationKey
+	GetMissedVoteBitArrayPrefixKey  = types.GetMissedVoteBitArrayPrefixKey
+	GetMissedVoteBitArrayKey        = types.GetMissedVoteBitArrayKey
+	GetVotingInfoKey                = types.GetVotingInfoKey
+	GetAggregatePrevoteKey          = types.GetAggregatePrevoteKey
+	GetAggregateVoteKey             = types.GetAggregateVoteKey
+	GetPriceHistoryPrefixKey        = types.GetPriceHistoryPrefixKey
+	GetPriceHistoryKey              = types.GetPriceHistoryKey
+	GetPriceHistoryIndexKey         = types.GetPriceHistoryIndexKey
+	GetUnsettledPeriodsKey          = types.GetUnsettledPeriodsKey
+	GetPerformanceKey               = types.GetPerformanceKey
+	NewMsgPricePrevote              = types.NewMsgPricePrevote
+	NewMsgPriceVote                 = types.NewMsgPriceVote
+	NewMsgDelegateFeederPermission  = types.NewMsgDelegateFeederPermission
+	NewMsgAggregatePricePrevote     = types.NewMsgAggregatePricePrevote
+	NewMsgAggregatePriceVote        = types.NewMsgAggregatePriceVote
+	NewMsgUnjail                    = types.NewMsgUnjail
+	DefaultParams                   = types.DefaultParams
+	NewQueryPriceParams             = types.NewQueryPriceParams
+	NewQueryPrevotesParams          = types.NewQueryPrevotesParams
+	NewQueryVotesParams             = types.NewQueryVotesParams
+	NewQueryFeederDelegationParams  = types.NewQueryFeederDelegationParams
+	NewQueryVotingInfoParams        = types.NewQueryVotingInfoParams
+	NewQueryVotingInfosParams       = types.NewQueryVotingInfosParams
+	NewQueryAggregatePrevoteParams  = types.NewQueryAggregatePrevoteParams
+	NewQueryAggregateVoteParams     = types.NewQueryAggregateVoteParams
+	NewQueryHistoricalPriceParams   = types.NewQueryHistoricalPriceParams
+	NewQueryTWAPParams              = types.NewQueryTWAPParams
+	NewQueryPerformanceParams       = types.NewQueryPerformanceParams
+	NewQueryPerformancesParams      = types.NewQueryPerformancesParams
+	NewPricePrevote                 = types.NewPricePrevote
+	VoteHash                        = types.VoteHash
+	NewPriceVote                    = types.NewPriceVote
+	NewVotingInfo                   = types.NewVotingInfo
+	NewPriceTuple                   = types.NewPriceTuple
+	ParsePriceTuples                = types.ParsePriceTuples
+	NewAggregatePricePrevote        = types.NewAggregatePricePrevote
+	AggregateVoteHash               = types.AggregateVoteHash
+	NewAggregatePriceVote           = types.NewAggregatePriceVote
+	NewPriceSnapshot                = types.NewPriceSnapshot
+	NewPriceInfo                    = types.NewPriceInfo
+	NewDenomDeviation               = types.NewDenomDeviation
+	NewValidatorPerformance         = types.NewValidatorPerformance
+	NewDenomTallyStrategy           = types.NewDenomTallyStrategy
+	NewKeeper                       = keeper.NewKeeper
+	ParamKeyTable                   = keeper.ParamKeyTable
+	NewQuerier                      = keeper.NewQuerier
 
 	// variable aliases
 	ModuleCdc                           = types.ModuleCdc
@@ -94,6 +157,12 @@ var (
 	FeederDelegationKey                 = types.FeederDelegationKey
 	MissedVoteBitArrayKey               = types.MissedVoteBitArrayKey
 	VotingInfoKey                       = types.VotingInfoKey
+	AggregatePrevoteKey                 = types.AggregatePrevoteKey
+	AggregateVoteKey                    = types.AggregateVoteKey
+	PriceHistoryKey                     = types.PriceHistoryKey
+	PriceHistoryIndexKey                = types.PriceHistoryIndexKey
+	UnsettledPeriodsKey                 = types.UnsettledPeriodsKey
+	PerformanceKey                      = types.PerformanceKey
 	ParamStoreKeyVotePeriod             = types.ParamStoreKeyVotePeriod
 	ParamStoreKeyVoteThreshold          = types.ParamStoreKeyVoteThreshold
 	ParamStoreKeyRewardBand             = types.ParamStoreKeyRewardBand
@@ -101,11 +170,24 @@ var (
 	ParamStoreKeyVotesWindow            = types.ParamStoreKeyVotesWindow
 	ParamStoreKeyMinValidVotesPerWindow = types.ParamStoreKeyMinValidVotesPerWindow
 	ParamStoreKeySlashFraction          = types.ParamStoreKeySlashFraction
+	ParamStoreKeyWhitelist              = types.ParamStoreKeyWhitelist
+	ParamStoreKeyPriceHistoryRetention  = types.ParamStoreKeyPriceHistoryRetention
+	ParamStoreKeyAbstainBudgetPerWindow = types.ParamStoreKeyAbstainBudgetPerWindow
+	ParamStoreKeyReferenceDenom         = types.ParamStoreKeyReferenceDenom
+	ParamStoreKeyJailDuration           = types.ParamStoreKeyJailDuration
+	ParamStoreKeyTallyStrategies        = types.ParamStoreKeyTallyStrategies
+	ParamStoreKeyMaxPriceChange         = types.ParamStoreKeyMaxPriceChange
+	ParamStoreKeyPriceBandMode          = types.ParamStoreKeyPriceBandMode
+	ParamStoreKeyMaxUnsettledPeriods    = types.ParamStoreKeyMaxUnsettledPeriods
 	DefaultVoteThreshold                = types.DefaultVoteThreshold
 	DefaultRewardBand                   = types.DefaultRewardBand
 	DefaultRewardFraction               = types.DefaultRewardFraction
 	DefaultMinValidVotesPerWindow       = types.DefaultMinValidVotesPerWindow
 	DefaultSlashFraction                = types.DefaultSlashFraction
+	DefaultAbstainBudgetPerWindow       = types.DefaultAbstainBudgetPerWindow
+	DefaultWhitelist                    = types.DefaultWhitelist
+	DefaultTallyStrategies              = types.DefaultTallyStrategies
+	DefaultMaxPriceChange               = types.DefaultMaxPriceChange
 )
 
 type (
@@ -121,6 +203,9 @@ type (
 	MsgPricePrevote             = types.MsgPricePrevote
 	MsgPriceVote                = types.MsgPriceVote
 	MsgDelegateFeederPermission = types.MsgDelegateFeederPermission
+	MsgAggregatePricePrevote    = types.MsgAggregatePricePrevote
+	MsgAggregatePriceVote       = types.MsgAggregatePriceVote
+	MsgUnjail                   = types.MsgUnjail
 	Params                      = types.Params
 	QueryPriceParams            = types.QueryPriceParams
 	QueryPrevotesParams         = types.QueryPrevotesParams
@@ -128,11 +213,35 @@ type (
 	QueryFeederDelegationParams = types.QueryFeederDelegationParams
 	QueryVotingInfoParams       = types.QueryVotingInfoParams
 	QueryVotingInfosParams      = types.QueryVotingInfosParams
+	QueryAggregatePrevoteParams = types.QueryAggregatePrevoteParams
+	QueryAggregateVoteParams    = types.QueryAggregateVoteParams
+	QueryHistoricalPriceParams  = types.QueryHistoricalPriceParams
+	QueryTWAPParams             = types.QueryTWAPParams
+	QueryPerformanceParams      = types.QueryPerformanceParams
+	QueryPerformancesParams     = types.QueryPerformancesParams
 	PricePrevote                = types.PricePrevote
 	PricePrevotes               = types.PricePrevotes
 	PriceVote                   = types.PriceVote
 	PriceVotes                  = types.PriceVotes
 	VotingInfo                  = types.VotingInfo
+	PriceTuple                  = types.PriceTuple
+	PriceTuples                 = types.PriceTuples
+	AggregatePricePrevote       = types.AggregatePricePrevote
+	AggregatePriceVote          = types.AggregatePriceVote
+	PriceSnapshot               = types.PriceSnapshot
+	PriceSnapshots              = types.PriceSnapshots
+	PriceInfo                   = types.PriceInfo
+	BallotPreview               = types.BallotPreview
+	BallotPreviews              = types.BallotPreviews
+	DenomDeviation              = types.DenomDeviation
+	ValidatorPerformance        = types.ValidatorPerformance
+	ValidatorPerformances       = types.ValidatorPerformances
+	TallyStrategy               = types.TallyStrategy
+	WeightedMedianStrategy      = types.WeightedMedianStrategy
+	TrimmedMeanStrategy         = types.TrimmedMeanStrategy
+	FilteredMedianStrategy      = types.FilteredMedianStrategy
+	DenomTallyStrategy          = types.DenomTallyStrategy
+	DenomTallyStrategies        = types.DenomTallyStrategies
 	Hooks                       = keeper.Hooks
 	Keeper                      = keeper.Keeper
 )
@@ -0,0 +1,357 @@
+package cli
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/terra-project/core/x/oracle/internal/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/libs/cli"
+)
+
+const (
+	flagSource       = "source"
+	flagStateFile    = "state-file"
+	flagPollInterval = "poll-interval"
+
+	defaultStateFile = "oracle-feeder.json"
+)
+
+// PriceSource provides the prices of Luna the feeder votes on
+type PriceSource interface {
+	Prices() (types.PriceTuples, error)
+}
+
+// NewPriceSource returns a PriceSource polling the location; an http(s) endpoint when the
+// location is an URL, a local file otherwise. Both return a JSON list of denom and price pairs:
+// [{"denom":"ukrw","price":"8888.0"},{"denom":"uusd","price":"1.243"}]
+func NewPriceSource(location string) PriceSource {
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		return httpPriceSource{url: location, client: &http.Client{Timeout: 10 * time.Second}}
+	}
+
+	return filePriceSource{path: location}
+}
+
+type filePriceSource struct {
+	path string
+}
+
+// Prices implements PriceSource
+func (s filePriceSource) Prices() (types.PriceTuples, error) {
+	bz, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsePriceSourceResponse(bz)
+}
+
+type httpPriceSource struct {
+	url    string
+	client *http.Client
+}
+
+// Prices implements PriceSource
+func (s httpPriceSource) Prices() (types.PriceTuples, error) {
+	res, err := s.client.Get(s.url)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("price source %s responded with status %s", s.url, res.Status)
+	}
+
+	bz, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsePriceSourceResponse(bz)
+}
+
+// parsePriceSourceResponse decodes the prices and checks them the same way an aggregate vote is checked
+func parsePriceSourceResponse(bz []byte) (types.PriceTuples, error) {
+	var prices types.PriceTuples
+	if err := json.Unmarshal(bz, &prices); err != nil {
+		return nil, errors.Wrap(err, "price source response is not a list of denom and price pairs")
+	}
+
+	return types.ParsePriceTuples(prices.String())
+}
+
+// feederState is the aggregate prevote of the feeder waiting to be revealed,
+// persisted so that a restarted feeder can still reveal it.
+type feederState struct {
+	Period int64  `json:"period"`
+	Salt   string `json:"salt"`
+	Prices string `json:"prices"`
+}
+
+func loadFeederState(path string) (state feederState, err error) {
+	bz, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return feederState{}, nil
+	} else if err != nil {
+		return
+	}
+
+	err = json.Unmarshal(bz, &state)
+	return
+}
+
+// saveFeederState writes the state through a temporary file, so a crash never leaves a partial state behind
+func saveFeederState(path string, state feederState) error {
+	bz, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := path + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, bz, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
+// generateSalt returns a random salt of the maximum length accepted by an aggregate vote
+func generateSalt() (string, error) {
+	bz := make([]byte, 2)
+	if _, err := rand.Read(bz); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bz), nil
+}
+
+// buildFeederMsgs returns the reveal of the pending aggregate prevote, if it is still on chain,
+// followed by the aggregate prevote of the prices for the period.
+func buildFeederMsgs(state feederState, pending *types.AggregatePricePrevote, salt string, prices string,
+	feeder sdk.AccAddress, validator sdk.ValAddress) ([]sdk.Msg, error) {
+
+	var msgs []sdk.Msg
+	if pending != nil && len(state.Salt) != 0 {
+		hashBytes, err := types.AggregateVoteHash(state.Salt, state.Prices, validator)
+		if err != nil {
+			return nil, err
+		}
+
+		if pending.Hash == hex.EncodeToString(hashBytes) {
+			msgs = append(msgs, types.NewMsgAggregatePriceVote(state.Salt, state.Prices, feeder, validator))
+		}
+	}
+
+	hashBytes, err := types.AggregateVoteHash(salt, prices, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs = append(msgs, types.NewMsgAggregatePricePrevote(hex.EncodeToString(hashBytes), feeder, validator))
+
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+
+	return msgs, nil
+}
+
+// GetCmdFeeder runs a price feeder submitting aggregate votes and prevotes every vote period.
+func GetCmdFeeder(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feeder [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Run a price feeder voting on behalf of a validator every vote period",
+		Long: strings.TrimSpace(`
+Run a price feeder voting on behalf of a validator every vote period. At the beginning of each
+vote period the feeder reads the prices from the source, and submits the aggregate vote revealing
+the prevote of the previous period together with the aggregate prevote of the new prices in one tx.
+
+The source is either a local file or an http(s) endpoint returning a JSON list of denom and price pairs:
+[{"denom":"ukrw","price":"8888.0"},{"denom":"uusd","price":"1.243"}]
+
+Salts of the pending prevote are persisted in the state file, so the feeder can be restarted
+without missing the reveal. The tx is signed with the key given by --from, which should be the
+feeder delegated by the validator with "terracli tx oracle set-feeder".
+
+$ terracli oracle feeder terravaloper1... --source http://localhost:8532/prices --from feeder --chain-id columbus-3
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return errors.Wrap(err, "validator address is invalid")
+			}
+
+			location := viper.GetString(flagSource)
+			if len(location) == 0 {
+				return fmt.Errorf("--%s is required", flagSource)
+			}
+			source := NewPriceSource(location)
+
+			statePath := viper.GetString(flagStateFile)
+			if len(statePath) == 0 {
+				statePath = filepath.Join(viper.GetString(cli.HomeFlag), defaultStateFile)
+			}
+
+			state, err := loadFeederState(statePath)
+			if err != nil {
+				return err
+			}
+
+			passphrase, err := keys.GetPassphrase(cliCtx.GetFromName())
+			if err != nil {
+				return err
+			}
+
+			for {
+				state, err = feedPrices(cliCtx, txBldr, passphrase, validator, source, state, statePath)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "oracle feeder: %s\n", err)
+				}
+
+				time.Sleep(viper.GetDuration(flagPollInterval))
+			}
+		},
+	}
+
+	cmd.Flags().String(flagSource, "", "local file or http(s) endpoint providing the prices")
+	cmd.Flags().String(flagStateFile, "", fmt.Sprintf("file persisting the pending prevote (default <home>/%s)", defaultStateFile))
+	cmd.Flags().Duration(flagPollInterval, 5*time.Second, "interval between polls of the node")
+
+	return cmd
+}
+
+// feedPrices submits the vote and prevote of the current vote period, unless already submitted.
+// Returns the state to reveal in the next vote period.
+func feedPrices(cliCtx context.CLIContext, txBldr auth.TxBuilder, passphrase string, validator sdk.ValAddress,
+	source PriceSource, state feederState, statePath string) (feederState, error) {
+
+	height, err := rpc.GetChainHeight(cliCtx)
+	if err != nil {
+		return state, err
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters), nil)
+	if err != nil {
+		return state, err
+	}
+
+	var params types.Params
+	cliCtx.Codec.MustUnmarshalJSON(res, &params)
+
+	// The tx lands in the next block at the earliest; skip the last block of a period,
+	// from which the tx could slip into the next period.
+	nextHeight := height + 1
+	period := nextHeight / params.VotePeriod
+	if period <= state.Period || (params.VotePeriod > 1 && (nextHeight+1)%params.VotePeriod == 0) {
+		return state, nil
+	}
+
+	prices, err := source.Prices()
+	if err != nil {
+		return state, err
+	}
+
+	salt, err := generateSalt()
+	if err != nil {
+		return state, err
+	}
+
+	pending, err := queryAggregatePrevote(cliCtx, validator)
+	if err != nil {
+		return state, err
+	}
+
+	msgs, err := buildFeederMsgs(state, pending, salt, prices.String(), cliCtx.GetFromAddress(), validator)
+	if err != nil {
+		return state, err
+	}
+
+	txBldr, err = utils.PrepareTxBuilder(txBldr, cliCtx)
+	if err != nil {
+		return state, err
+	}
+
+	if txBldr.SimulateAndExecute() {
+		txBldr, err = utils.EnrichWithGas(txBldr, cliCtx, msgs)
+		if err != nil {
+			return state, err
+		}
+	}
+
+	txBytes, err := txBldr.BuildAndSign(cliCtx.GetFromName(), passphrase, msgs)
+	if err != nil {
+		return state, err
+	}
+
+	txRes, err := cliCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return state, err
+	}
+
+	if txRes.Code != 0 {
+		return state, fmt.Errorf("tx %s failed: %s", txRes.TxHash, txRes.RawLog)
+	}
+
+	state = feederState{Period: period, Salt: salt, Prices: prices.String()}
+	if err := saveFeederState(statePath, state); err != nil {
+		return state, err
+	}
+
+	return state, cliCtx.PrintOutput(txRes)
+}
+
+// queryAggregatePrevote returns the aggregate prevote of the validator, nil if there is none
+func queryAggregatePrevote(cliCtx context.CLIContext, validator sdk.ValAddress) (*types.AggregatePricePrevote, error) {
+	bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryAggregatePrevoteParams(validator))
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAggregatePrevote), bz)
+	if err != nil {
+		// The node returns the ABCI log of the query error, which holds its codespace and code
+		var queryErr struct {
+			Codespace sdk.CodespaceType `json:"codespace"`
+			Code      sdk.CodeType      `json:"code"`
+		}
+		if json.Unmarshal([]byte(err.Error()), &queryErr) == nil &&
+			queryErr.Codespace == types.DefaultCodespace && queryErr.Code == types.CodeNoAggregatePrevote {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var prevote types.AggregatePricePrevote
+	cliCtx.Codec.MustUnmarshalJSON(res, &prevote)
+	return &prevote, nil
+}
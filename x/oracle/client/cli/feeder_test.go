@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/oracle/internal/types"
+)
+
+const testPrices = `[{"denom":"ukrw","price":"8888.0"},{"denom":"uusd","price":"1.243"}]`
+
+func TestPriceSource(t *testing.T) {
+	expected := types.PriceTuples{
+		types.NewPriceTuple(core.MicroKRWDenom, sdk.NewDecWithPrec(88880, 1)),
+		types.NewPriceTuple(core.MicroUSDDenom, sdk.NewDecWithPrec(1243, 3)),
+	}
+
+	dir, err := ioutil.TempDir("", "feeder")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "prices.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(testPrices), 0600))
+
+	prices, err := NewPriceSource(path).Prices()
+	require.NoError(t, err)
+	require.Equal(t, expected, prices)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, testPrices)
+	}))
+	defer server.Close()
+
+	prices, err = NewPriceSource(server.URL).Prices()
+	require.NoError(t, err)
+	require.Equal(t, expected, prices)
+
+	// Negative prices are rejected like in an aggregate vote
+	require.NoError(t, ioutil.WriteFile(path, []byte(`[{"denom":"ukrw","price":"-1.0"}]`), 0600))
+	_, err = NewPriceSource(path).Prices()
+	require.Error(t, err)
+
+	_, err = NewPriceSource(filepath.Join(dir, "missing.json")).Prices()
+	require.Error(t, err)
+}
+
+func TestFeederState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "feeder")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, defaultStateFile)
+
+	// No state before the first prevote
+	state, err := loadFeederState(path)
+	require.NoError(t, err)
+	require.Equal(t, feederState{}, state)
+
+	expected := feederState{Period: 10, Salt: "1a2b", Prices: "ukrw:8888.0"}
+	require.NoError(t, saveFeederState(path, expected))
+
+	state, err = loadFeederState(path)
+	require.NoError(t, err)
+	require.Equal(t, expected, state)
+}
+
+func TestGenerateSalt(t *testing.T) {
+	salt, err := generateSalt()
+	require.NoError(t, err)
+	require.Equal(t, 4, len(salt))
+}
+
+func TestBuildFeederMsgs(t *testing.T) {
+	feeder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	validator := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+	state := feederState{Period: 1, Salt: "1", Prices: "ukrw:8888.0"}
+
+	// Nothing to reveal
+	msgs, err := buildFeederMsgs(state, nil, "2", "ukrw:8889.0", feeder, validator)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(msgs))
+
+	hashBytes, err := types.AggregateVoteHash("2", "ukrw:8889.0", validator)
+	require.NoError(t, err)
+	require.Equal(t, types.NewMsgAggregatePricePrevote(hex.EncodeToString(hashBytes), feeder, validator), msgs[0])
+
+	// Pending prevote of the state is revealed first
+	hashBytes, err = types.AggregateVoteHash(state.Salt, state.Prices, validator)
+	require.NoError(t, err)
+	pending := types.NewAggregatePricePrevote(hex.EncodeToString(hashBytes), validator, 1)
+
+	msgs, err = buildFeederMsgs(state, &pending, "2", "ukrw:8889.0", feeder, validator)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(msgs))
+	require.Equal(t, types.NewMsgAggregatePriceVote(state.Salt, state.Prices, feeder, validator), msgs[0])
+
+	// Prevote on chain which does not match the state is not revealed
+	pending = types.NewAggregatePricePrevote("0000", validator, 1)
+	msgs, err = buildFeederMsgs(state, &pending, "2", "ukrw:8889.0", feeder, validator)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(msgs))
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/terra-project/core/x/oracle/internal/types"
@@ -11,6 +12,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagPage  = "page"
+	flagLimit = "limit"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -30,6 +37,13 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryParams(cdc),
 		GetCmdQueryFeederDelegation(cdc),
 		GetCmdQueryVotingInfo(cdc),
+		GetCmdQueryAggregatePrevote(cdc),
+		GetCmdQueryAggregateVote(cdc),
+		GetCmdQueryHistoricalPrice(cdc),
+		GetCmdQueryTWAP(cdc),
+		GetCmdQueryPerformance(cdc),
+		GetCmdQueryPerformances(cdc),
+		GetCmdQueryBallotPreview(cdc),
 	)...)
 
 	return oracleQueryCmd
@@ -43,7 +57,7 @@ func GetCmdQueryPrice(cdc *codec.Codec) *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Short: "Query the current Luna exchange rate w.r.t an asset",
 		Long: strings.TrimSpace(`
-Query the current exchange rate of Luna with an asset. You can find the current list of active denoms by running: terracli query oracle active
+Query the current exchange rate of Luna with an asset, with the height and time it was tallied. You can find the current list of active denoms by running: terracli query oracle active
 
 $ terracli query oracle price --denom ukrw
 `),
@@ -63,9 +77,9 @@ $ terracli query oracle price --denom ukrw
 				return err
 			}
 
-			var price sdk.Dec
-			cdc.MustUnmarshalJSON(res, &price)
-			return cliCtx.PrintOutput(price)
+			var info types.PriceInfo
+			cdc.MustUnmarshalJSON(res, &info)
+			return cliCtx.PrintOutput(info)
 		},
 	}
 	return cmd
@@ -300,3 +314,269 @@ $ <appcli> query oracle voting-info terravaloper...
 		},
 	}
 }
+
+// GetCmdQueryAggregatePrevote implements the query aggregate prevote of the validator command
+func GetCmdQueryAggregatePrevote(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-prevote [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query outstanding oracle aggregate prevote of a validator",
+		Long: strings.TrimSpace(`
+Query outstanding oracle aggregate prevote of a validator.
+
+$ terracli query oracle aggregate-prevote terravaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryAggregatePrevoteParams(validator)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAggregatePrevote), bz)
+			if err != nil {
+				return err
+			}
+
+			var aggregatePrevote types.AggregatePricePrevote
+			cdc.MustUnmarshalJSON(res, &aggregatePrevote)
+			return cliCtx.PrintOutput(aggregatePrevote)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryAggregateVote implements the query aggregate vote of the validator command
+func GetCmdQueryAggregateVote(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-vote [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query outstanding oracle aggregate vote of a validator",
+		Long: strings.TrimSpace(`
+Query outstanding oracle aggregate vote of a validator.
+
+$ terracli query oracle aggregate-vote terravaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryAggregateVoteParams(validator)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAggregateVote), bz)
+			if err != nil {
+				return err
+			}
+
+			var aggregateVote types.AggregatePriceVote
+			cdc.MustUnmarshalJSON(res, &aggregateVote)
+			return cliCtx.PrintOutput(aggregateVote)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryHistoricalPrice implements the query historical price command.
+func GetCmdQueryHistoricalPrice(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historical-price [denom] [height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the Luna exchange rate w.r.t an asset at a past height",
+		Long: strings.TrimSpace(`
+Query the exchange rate of Luna with an asset which was effective at the given height.
+Only the heights covered by the retained price history can be queried.
+
+$ terracli query oracle historical-price ukrw 1000
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			denom := args[0]
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("given height {%s} is not a valid format; height should be an integer", args[1])
+			}
+
+			params := types.NewQueryHistoricalPriceParams(denom, height)
+			bz, err := cliCtx.Codec.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryHistoricalPrice), bz)
+			if err != nil {
+				return err
+			}
+
+			var price sdk.Dec
+			cdc.MustUnmarshalJSON(res, &price)
+			return cliCtx.PrintOutput(price)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryTWAP implements the query time weighted average price command.
+func GetCmdQueryTWAP(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [denom] [periods]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the time weighted average Luna exchange rate w.r.t an asset",
+		Long: strings.TrimSpace(`
+Query the time weighted average exchange rate of Luna with an asset over the last given number of vote periods.
+
+$ terracli query oracle twap ukrw 60
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			denom := args[0]
+			periods, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("given periods {%s} is not a valid format; periods should be an integer", args[1])
+			}
+
+			params := types.NewQueryTWAPParams(denom, periods)
+			bz, err := cliCtx.Codec.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTWAP), bz)
+			if err != nil {
+				return err
+			}
+
+			var twap sdk.Dec
+			cdc.MustUnmarshalJSON(res, &twap)
+			return cliCtx.PrintOutput(twap)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryPerformance implements the query oracle performance of the validator command
+func GetCmdQueryPerformance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "performance [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the oracle performance statistics of a validator",
+		Long: strings.TrimSpace(`
+Query the ballots won and lost, the abstentions, the average deviation from the tallied median
+per denom, and the rewards earned by a validator since its first oracle vote.
+
+$ terracli query oracle performance terravaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryPerformanceParams(validator)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPerformance), bz)
+			if err != nil {
+				return err
+			}
+
+			var performance types.ValidatorPerformance
+			cdc.MustUnmarshalJSON(res, &performance)
+			return cliCtx.PrintOutput(performance)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryPerformances implements the query oracle performances of all validators command
+func GetCmdQueryPerformances(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "performances",
+		Args:  cobra.NoArgs,
+		Short: "Query the oracle performance statistics of all validators",
+		Long: strings.TrimSpace(`
+Query the oracle performance statistics of all validators, ordered by validator address.
+
+$ terracli query oracle performances --page 1 --limit 20
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryPerformancesParams(viper.GetInt(flagPage), viper.GetInt(flagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryPerformances), bz)
+			if err != nil {
+				return err
+			}
+
+			var performances types.ValidatorPerformances
+			cdc.MustUnmarshalJSON(res, &performances)
+			return cliCtx.PrintOutput(performances)
+		},
+	}
+
+	cmd.Flags().Int(flagPage, 1, "page of the validators to query")
+	cmd.Flags().Int(flagLimit, 100, "number of validators per page")
+
+	return cmd
+}
+
+// GetCmdQueryBallotPreview implements the query ballot preview command.
+func GetCmdQueryBallotPreview(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ballot-preview",
+		Args:  cobra.NoArgs,
+		Short: "Preview the tally of the votes revealed so far in the current vote period",
+		Long: strings.TrimSpace(`
+Preview the tally of the votes revealed so far in the current vote period. For every whitelisted denom,
+shows the power of its ballot and the threshold to pass, the weighted median and the price of the tally,
+the reward spread, and the voters who would win and lose the ballot.
+
+$ terracli query oracle ballot-preview
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBallotPreview), nil)
+			if err != nil {
+				return err
+			}
+
+			var previews types.BallotPreviews
+			cdc.MustUnmarshalJSON(res, &previews)
+			return cliCtx.PrintOutput(previews)
+		},
+	}
+
+	return cmd
+}
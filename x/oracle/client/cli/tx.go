@@ -33,6 +33,9 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdPricePrevote(cdc),
 		GetCmdPriceVote(cdc),
 		GetCmdDelegateFeederPermission(cdc),
+		GetCmdAggregatePricePrevote(cdc),
+		GetCmdAggregatePriceVote(cdc),
+		GetCmdUnjail(cdc),
 	)...)
 
 	return oracleTxCmd
@@ -208,3 +211,155 @@ where "terra1..." is the address you want to delegate your voting rights to.
 
 	return cmd
 }
+
+// GetCmdAggregatePricePrevote will create an aggregatePricePrevote tx and sign it with the given key.
+func GetCmdAggregatePricePrevote(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-prevote [salt] [prices] [validator]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Submit an oracle aggregate prevote for the prices of Luna",
+		Long: strings.TrimSpace(`
+Submit an oracle aggregate prevote for the prices of Luna denominated in multiple denoms.
+The purpose of aggregate prevote is to hide vote prices with hash which is formatted 
+as hex string in SHA256("salt:denom:price,denom:price,...:voter")
+
+# Aggregate Prevote
+$ terracli tx oracle aggregate-prevote 1234 ukrw:8888.0,uusd:1.243
+
+where "ukrw:8888.0,uusd:1.243" is the list of denom and price pairs of micro Luna from the voter's point of view.
+
+If voting from a voting delegate, set "validator" to the address of the validator to vote on behalf of:
+$ terracli tx oracle aggregate-prevote 1234 ukrw:8888.0,uusd:1.243 terravaloper1...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			salt := args[0]
+			prices := args[1]
+
+			// Check the prices are well formatted before hashing them
+			if _, err := types.ParsePriceTuples(prices); err != nil {
+				return fmt.Errorf("given prices {%s} is not a valid format; prices should be formatted as denom:price,denom:price", prices)
+			}
+
+			// Get from address
+			voter := cliCtx.GetFromAddress()
+
+			// By default the voter is voting on behalf of itself
+			validator := sdk.ValAddress(voter)
+
+			// Override validator if validator is given
+			if len(args) == 3 {
+				parsedVal, err := sdk.ValAddressFromBech32(args[2])
+				if err != nil {
+					return errors.Wrap(err, "validator address is invalid")
+				}
+				validator = parsedVal
+			}
+
+			hashBytes, err := types.AggregateVoteHash(salt, prices, validator)
+			if err != nil {
+				return err
+			}
+
+			hash := hex.EncodeToString(hashBytes)
+
+			msg := types.NewMsgAggregatePricePrevote(hash, voter, validator)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdAggregatePriceVote will create an aggregatePriceVote tx and sign it with the given key.
+func GetCmdAggregatePriceVote(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-vote [salt] [prices] [validator]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Submit an oracle aggregate vote for the prices of Luna",
+		Long: strings.TrimSpace(`
+Submit an aggregate vote for the prices of Luna denominated in multiple denoms. Companion to an aggregate prevote submitted in the previous vote period. 
+
+$ terracli tx oracle aggregate-vote 1234 ukrw:8888.0,uusd:1.243
+
+where "ukrw:8888.0,uusd:1.243" is the list of denom and price pairs of micro Luna from the voter's point of view.
+
+"salt" and "prices" should match the ones used to generate the SHA256 hex in the associated aggregate prevote. 
+
+If voting from a voting delegate, set "validator" to the address of the validator to vote on behalf of:
+$ terracli tx oracle aggregate-vote 1234 ukrw:8888.0,uusd:1.243 terravaloper1....
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			salt := args[0]
+			prices := args[1]
+
+			// Get from address
+			voter := cliCtx.GetFromAddress()
+
+			// By default the voter is voting on behalf of itself
+			validator := sdk.ValAddress(voter)
+
+			// Override validator if validator is given
+			if len(args) == 3 {
+				parsedVal, err := sdk.ValAddressFromBech32(args[2])
+				if err != nil {
+					return errors.Wrap(err, "validator address is invalid")
+				}
+				validator = parsedVal
+			}
+
+			msg := types.NewMsgAggregatePriceVote(salt, prices, voter, validator)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdUnjail will create an unjail tx and sign it with the given key.
+func GetCmdUnjail(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail",
+		Args:  cobra.NoArgs,
+		Short: "Unjail a validator jailed by the oracle",
+		Long: strings.TrimSpace(`
+Unjail a validator which was jailed for missing too many oracle votes, once the oracle jail duration has elapsed.
+
+$ terracli tx oracle unjail --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr := sdk.ValAddress(cliCtx.GetFromAddress())
+
+			msg := types.NewMsgUnjail(valAddr)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -7,9 +7,11 @@ import (
 
 //nolint
 const (
-	RestDenom = "denom"
-	RestVoter = "voter"
-	RestPrice = "price"
+	RestDenom   = "denom"
+	RestVoter   = "voter"
+	RestPrice   = "price"
+	RestHeight  = "height"
+	RestPeriods = "periods"
 )
 
 // RegisterRoutes registers oracle-related REST handlers to a router
@@ -26,6 +26,10 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 		}
 	}
 
+	for _, performance := range data.Performances {
+		keeper.SetValidatorPerformance(ctx, performance)
+	}
+
 	keeper.SetParams(ctx, data.Params)
 }
 
@@ -51,5 +55,11 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
 		return false
 	})
 
-	return NewGenesisState(params, votingInfos, missedVotes)
+	performances := ValidatorPerformances{}
+	keeper.IterateValidatorPerformances(ctx, func(performance ValidatorPerformance) (stop bool) {
+		performances = append(performances, performance)
+		return false
+	})
+
+	return NewGenesisState(params, votingInfos, missedVotes, performances)
 }
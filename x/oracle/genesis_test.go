@@ -0,0 +1,40 @@
+package oracle
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/oracle/internal/keeper"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	performance := NewValidatorPerformance(keeper.ValAddrs[0])
+	performance.BallotsWon = 3
+	performance.BallotsLost = 1
+	performance.Abstentions = 2
+	performance.AddDeviation(core.MicroKRWDenom, sdk.NewDecWithPrec(5, 1))
+	performance.Rewards = sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100))
+	input.OracleKeeper.SetValidatorPerformance(input.Ctx, performance)
+	input.OracleKeeper.RecordAbstention(input.Ctx, keeper.ValAddrs[1])
+
+	genesis := ExportGenesis(input.Ctx, input.OracleKeeper)
+	require.Len(t, genesis.Performances, 2)
+
+	newInput := keeper.CreateTestInput(t)
+	InitGenesis(newInput.Ctx, newInput.OracleKeeper, genesis)
+
+	imported, found := newInput.OracleKeeper.GetValidatorPerformance(newInput.Ctx, keeper.ValAddrs[0])
+	require.True(t, found)
+	require.Equal(t, performance, imported)
+
+	imported, found = newInput.OracleKeeper.GetValidatorPerformance(newInput.Ctx, keeper.ValAddrs[1])
+	require.True(t, found)
+	require.Equal(t, int64(1), imported.Abstentions)
+
+	require.True(t, genesis.Equal(ExportGenesis(newInput.Ctx, newInput.OracleKeeper)))
+}
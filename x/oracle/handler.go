@@ -21,6 +21,12 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgPriceVote(ctx, k, msg)
 		case MsgDelegateFeederPermission:
 			return handleMsgDelegateFeederPermission(ctx, k, msg)
+		case MsgAggregatePricePrevote:
+			return handleMsgAggregatePricePrevote(ctx, k, msg)
+		case MsgAggregatePriceVote:
+			return handleMsgAggregatePriceVote(ctx, k, msg)
+		case MsgUnjail:
+			return handleMsgUnjail(ctx, k, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized oracle message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -43,6 +49,11 @@ func handleMsgPricePrevote(ctx sdk.Context, keeper Keeper, ppm MsgPricePrevote)
 		return staking.ErrNoValidatorFound(keeper.Codespace()).Result()
 	}
 
+	// Check the denom is voted by the oracle
+	if !keeper.Whitelist(ctx).Contains(ppm.Denom) {
+		return ErrUnknownDenomination(keeper.Codespace(), ppm.Denom).Result()
+	}
+
 	prevote := NewPricePrevote(ppm.Hash, ppm.Denom, ppm.Validator, ctx.BlockHeight())
 	keeper.AddPrevote(ctx, prevote)
 
@@ -79,6 +90,11 @@ func handleMsgPriceVote(ctx sdk.Context, keeper Keeper, pvm MsgPriceVote) sdk.Re
 
 	params := keeper.GetParams(ctx)
 
+	// Check the denom is voted by the oracle
+	if !params.Whitelist.Contains(pvm.Denom) {
+		return ErrUnknownDenomination(keeper.Codespace(), pvm.Denom).Result()
+	}
+
 	// Get prevote
 	prevote, err := keeper.GetPrevote(ctx, pvm.Denom, pvm.Validator)
 	if err != nil {
@@ -149,3 +165,128 @@ func handleMsgDelegateFeederPermission(ctx sdk.Context, keeper Keeper, dfpm MsgD
 
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+// handleMsgAggregatePricePrevote handles a MsgAggregatePricePrevote
+func handleMsgAggregatePricePrevote(ctx sdk.Context, keeper Keeper, appm MsgAggregatePricePrevote) sdk.Result {
+	if !appm.Feeder.Equals(appm.Validator) {
+		delegate := keeper.GetFeedDelegate(ctx, appm.Validator)
+		if !delegate.Equals(appm.Feeder) {
+			return ErrNoVotingPermission(keeper.Codespace(), appm.Feeder, appm.Validator).Result()
+		}
+	}
+
+	// Check that the given validator exists
+	val := keeper.StakingKeeper.Validator(ctx, appm.Validator)
+	if val == nil {
+		return staking.ErrNoValidatorFound(keeper.Codespace()).Result()
+	}
+
+	aggregatePrevote := NewAggregatePricePrevote(appm.Hash, appm.Validator, ctx.BlockHeight())
+	keeper.AddAggregatePrevote(ctx, aggregatePrevote)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAggregatePrevote,
+			sdk.NewAttribute(types.AttributeKeyVoter, appm.Validator.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeder, appm.Feeder.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handleMsgAggregatePriceVote handles a MsgAggregatePriceVote
+func handleMsgAggregatePriceVote(ctx sdk.Context, keeper Keeper, apvm MsgAggregatePriceVote) sdk.Result {
+	if !apvm.Feeder.Equals(apvm.Validator) {
+		delegate := keeper.GetFeedDelegate(ctx, apvm.Validator)
+		if !delegate.Equals(apvm.Feeder) {
+			return ErrNoVotingPermission(keeper.Codespace(), apvm.Feeder, apvm.Validator).Result()
+		}
+	}
+
+	// Check that the given validator exists
+	val := keeper.StakingKeeper.Validator(ctx, apvm.Validator)
+	if val == nil {
+		return staking.ErrNoValidatorFound(keeper.Codespace()).Result()
+	}
+
+	params := keeper.GetParams(ctx)
+
+	// Get aggregate prevote
+	aggregatePrevote, err := keeper.GetAggregatePrevote(ctx, apvm.Validator)
+	if err != nil {
+		return ErrNoAggregatePrevote(keeper.Codespace(), apvm.Validator).Result()
+	}
+
+	// Check a msg is submitted porper period
+	if (ctx.BlockHeight()/params.VotePeriod)-(aggregatePrevote.SubmitBlock/params.VotePeriod) != 1 {
+		return ErrNotRevealPeriod(keeper.Codespace()).Result()
+	}
+
+	priceTuples, err2 := ParsePriceTuples(apvm.Prices)
+	if err2 != nil {
+		return ErrInvalidMsgFormat(keeper.Codespace(), err2.Error()).Result()
+	}
+
+	// Check all denoms are voted by the oracle
+	for _, tuple := range priceTuples {
+		if !params.Whitelist.Contains(tuple.Denom) {
+			return ErrUnknownDenomination(keeper.Codespace(), tuple.Denom).Result()
+		}
+	}
+
+	// Verify the prices string with the aggregate prevote hash
+	bz, _ := hex.DecodeString(aggregatePrevote.Hash) // prevote hash
+	bz2, err2 := AggregateVoteHash(apvm.Salt, apvm.Prices, aggregatePrevote.Voter)
+	if err2 != nil {
+		return ErrVerificationFailed(keeper.Codespace(), bz, []byte{}).Result()
+	}
+
+	if !bytes.Equal(bz, bz2) {
+		return ErrVerificationFailed(keeper.Codespace(), bz, bz2).Result()
+	}
+
+	// Move aggregate prevote to aggregate vote with given prices
+	keeper.DeleteAggregatePrevote(ctx, aggregatePrevote)
+	keeper.AddAggregateVote(ctx, NewAggregatePriceVote(priceTuples, apvm.Validator))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAggregateVote,
+			sdk.NewAttribute(types.AttributeKeyVoter, apvm.Validator.String()),
+			sdk.NewAttribute(types.AttributeKeyPrices, apvm.Prices),
+			sdk.NewAttribute(types.AttributeKeyFeeder, apvm.Feeder.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// handleMsgUnjail handles a MsgUnjail
+func handleMsgUnjail(ctx sdk.Context, keeper Keeper, msg MsgUnjail) sdk.Result {
+	err := keeper.Unjail(ctx, msg.ValidatorAddr)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnjail,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.ValidatorAddr.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -3,9 +3,12 @@ package oracle
 import (
 	"encoding/hex"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/mock"
@@ -112,3 +115,131 @@ func TestFeederDelegation(t *testing.T) {
 	res = h(input.Ctx, prevoteMsg)
 	require.True(t, res.IsOK())
 }
+
+func TestAggregatePrevoteVote(t *testing.T) {
+	input, h := setup(t)
+
+	salt := "1"
+	prices := "usdr:1000.23,ukrw:0.29,uusd:1000.12"
+	otherPrices := "usdr:1000.12,ukrw:0.29,uusd:1000.12"
+
+	bz, err := AggregateVoteHash(salt, prices, keeper.ValAddrs[0])
+	require.Nil(t, err)
+
+	aggregatePrevoteMsg := NewMsgAggregatePricePrevote(hex.EncodeToString(bz), keeper.Addrs[0], keeper.ValAddrs[0])
+	res := h(input.Ctx, aggregatePrevoteMsg)
+	require.True(t, res.IsOK())
+
+	// Unauthorized feeder
+	aggregatePrevoteMsg = NewMsgAggregatePricePrevote(hex.EncodeToString(bz), keeper.Addrs[1], keeper.ValAddrs[0])
+	res = h(input.Ctx, aggregatePrevoteMsg)
+	require.False(t, res.IsOK())
+
+	// Invalid reveal period
+	aggregatePriceVoteMsg := NewMsgAggregatePriceVote(salt, prices, keeper.Addrs[0], keeper.ValAddrs[0])
+	res = h(input.Ctx, aggregatePriceVoteMsg)
+	require.False(t, res.IsOK())
+
+	input.Ctx = input.Ctx.WithBlockHeight(2)
+	res = h(input.Ctx, aggregatePriceVoteMsg)
+	require.False(t, res.IsOK())
+
+	// Other prices than the prevoted ones
+	input.Ctx = input.Ctx.WithBlockHeight(1)
+	aggregatePriceVoteMsg = NewMsgAggregatePriceVote(salt, otherPrices, keeper.Addrs[0], keeper.ValAddrs[0])
+	res = h(input.Ctx, aggregatePriceVoteMsg)
+	require.False(t, res.IsOK())
+
+	// Valid reveal
+	aggregatePriceVoteMsg = NewMsgAggregatePriceVote(salt, prices, keeper.Addrs[0], keeper.ValAddrs[0])
+	res = h(input.Ctx, aggregatePriceVoteMsg)
+	require.True(t, res.IsOK())
+
+	aggregateVote, err := input.OracleKeeper.GetAggregateVote(input.Ctx, keeper.ValAddrs[0])
+	require.Nil(t, err)
+	priceTuples, err2 := ParsePriceTuples(prices)
+	require.Nil(t, err2)
+	require.Equal(t, priceTuples, aggregateVote.PriceTuples)
+
+	// The aggregate prevote is consumed by the reveal
+	_, err = input.OracleKeeper.GetAggregatePrevote(input.Ctx, keeper.ValAddrs[0])
+	require.Error(t, err)
+}
+
+func TestWhitelistCheck(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = DenomList{core.MicroKRWDenom, core.MicroSDRDenom}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Prevote on a denom outside of the whitelist is rejected
+	salt := "1"
+	bz, err := VoteHash(salt, randomPrice, core.MicroCNYDenom, keeper.ValAddrs[0])
+	require.Nil(t, err)
+
+	pricePrevoteMsg := NewMsgPricePrevote(hex.EncodeToString(bz), core.MicroCNYDenom, keeper.Addrs[0], keeper.ValAddrs[0])
+	res := h(input.Ctx, pricePrevoteMsg)
+	require.False(t, res.IsOK())
+
+	// Vote on a denom removed from the whitelist after the prevote is rejected
+	bz, err = VoteHash(salt, randomPrice, core.MicroSDRDenom, keeper.ValAddrs[0])
+	require.Nil(t, err)
+
+	pricePrevoteMsg = NewMsgPricePrevote(hex.EncodeToString(bz), core.MicroSDRDenom, keeper.Addrs[0], keeper.ValAddrs[0])
+	res = h(input.Ctx, pricePrevoteMsg)
+	require.True(t, res.IsOK())
+
+	prices := "usdr:1000.23,ucny:0.29"
+	bz, err = AggregateVoteHash(salt, prices, keeper.ValAddrs[0])
+	require.Nil(t, err)
+
+	aggregatePrevoteMsg := NewMsgAggregatePricePrevote(hex.EncodeToString(bz), keeper.Addrs[0], keeper.ValAddrs[0])
+	res = h(input.Ctx, aggregatePrevoteMsg)
+	require.True(t, res.IsOK())
+
+	params.Whitelist = DenomList{core.MicroKRWDenom}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	input.Ctx = input.Ctx.WithBlockHeight(1)
+	priceVoteMsg := NewMsgPriceVote(randomPrice, salt, core.MicroSDRDenom, keeper.Addrs[0], keeper.ValAddrs[0])
+	res = h(input.Ctx, priceVoteMsg)
+	require.False(t, res.IsOK())
+
+	// Aggregate vote containing a denom outside of the whitelist is rejected
+	aggregatePriceVoteMsg := NewMsgAggregatePriceVote(salt, prices, keeper.Addrs[0], keeper.ValAddrs[0])
+	res = h(input.Ctx, aggregatePriceVoteMsg)
+	require.False(t, res.IsOK())
+}
+
+func TestUnjail(t *testing.T) {
+	input, h := setup(t)
+
+	// Case 1: validator not jailed by the oracle
+	res := h(input.Ctx, NewMsgUnjail(keeper.ValAddrs[0]))
+	require.False(t, res.IsOK())
+	require.Equal(t, CodeValidatorNotJailed, res.Code)
+
+	// Case 2: unknown validator
+	res = h(input.Ctx, NewMsgUnjail(sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())))
+	require.False(t, res.IsOK())
+
+	// Case 3: validator jailed by the oracle, within the jail duration
+	ctx := input.Ctx.WithBlockTime(time.Unix(1000, 0))
+	validator := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
+	input.StakingKeeper.Jail(ctx, validator.GetConsAddr())
+
+	votingInfo := NewVotingInfo(keeper.ValAddrs[0], 0, 0, 0, 0)
+	votingInfo.Jailed = true
+	votingInfo.JailedUntil = ctx.BlockHeader().Time.Add(input.OracleKeeper.JailDuration(ctx))
+	input.OracleKeeper.SetVotingInfo(ctx, keeper.ValAddrs[0], votingInfo)
+
+	res = h(ctx, NewMsgUnjail(keeper.ValAddrs[0]))
+	require.False(t, res.IsOK())
+	require.Equal(t, CodeValidatorJailed, res.Code)
+
+	// Case 4: jail duration elapsed
+	res = h(ctx.WithBlockTime(votingInfo.JailedUntil), NewMsgUnjail(keeper.ValAddrs[0]))
+	require.True(t, res.IsOK())
+	require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsJailed())
+}
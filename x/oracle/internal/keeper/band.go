@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/oracle/internal/types"
+)
+
+// IsPriceUnsettled returns whether the last tally of the denom fell outside the price band,
+// i.e. whether the denom has a count of consecutive tallies outside the band
+func (k Keeper) IsPriceUnsettled(ctx sdk.Context, denom string) bool {
+	return k.GetUnsettledPeriods(ctx, denom) > 0
+}
+
+// GetUnsettledPeriods returns the number of consecutive tallies of the denom that fell outside the price band
+func (k Keeper) GetUnsettledPeriods(ctx sdk.Context, denom string) (periods int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUnsettledPeriodsKey(denom))
+	if bz == nil {
+		return 0
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &periods)
+	return
+}
+
+// SetUnsettledPeriods sets the number of consecutive tallies of the denom that fell outside the price band
+func (k Keeper) SetUnsettledPeriods(ctx sdk.Context, denom string, periods int64) {
+	store := ctx.KVStore(k.storeKey)
+	if periods <= 0 {
+		store.Delete(types.GetUnsettledPeriodsKey(denom))
+		return
+	}
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(periods)
+	store.Set(types.GetUnsettledPeriodsKey(denom), bz)
+}
+
+// ApplyPriceBand returns the price to store for the tallied price of the denom. A price within MaxPriceChange
+// of the last stored price of the denom is kept as is; outside of it, the price is clamped to the band or replaced by the
+// last price according to PriceBandMode, and the denom is left unsettled until a tally falls within the band.
+// A price still outside the band after MaxUnsettledPeriods consecutive tallies is accepted as is, so that
+// a lasting move settles. So is any price when the last price was tallied more than MaxUnsettledPeriods vote
+// periods ago, as the denom failed its tallies for longer than a move is held off.
+// Returns whether the tallied price was rejected, in which case the stored last price, along with the height
+// and time it was tallied at, is to be kept as is.
+func (k Keeper) ApplyPriceBand(ctx sdk.Context, denom string, price sdk.Dec) (bandedPrice sdk.Dec, rejected bool) {
+	maxChange := k.MaxPriceChange(ctx)
+	lastPrice, err := k.GetFreshLunaPrice(ctx, denom, k.MaxUnsettledPeriods(ctx))
+	if !maxChange.IsPositive() || err != nil {
+		k.SetUnsettledPeriods(ctx, denom, 0)
+		return price, false
+	}
+
+	lower := lastPrice.Mul(sdk.OneDec().Sub(maxChange))
+	upper := lastPrice.Mul(sdk.OneDec().Add(maxChange))
+	if price.GTE(lower) && price.LTE(upper) {
+		k.SetUnsettledPeriods(ctx, denom, 0)
+		return price, false
+	}
+
+	// the move has lasted long enough; follow it
+	periods := k.GetUnsettledPeriods(ctx, denom) + 1
+	if periods > k.MaxUnsettledPeriods(ctx) {
+		k.SetUnsettledPeriods(ctx, denom, 0)
+		return price, false
+	}
+
+	mode := k.PriceBandMode(ctx)
+	bandedPrice, rejected = lastPrice, true
+	if mode == types.PriceBandModeClamp {
+		bandedPrice, rejected = sdk.MinDec(sdk.MaxDec(price, lower), upper), false
+	}
+
+	k.SetUnsettledPeriods(ctx, denom, periods)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypePriceBand,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyPreviousPrice, lastPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyTallyPrice, price.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, bandedPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyMode, mode),
+		),
+	)
+
+	return bandedPrice, rejected
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/oracle/internal/types"
+)
+
+func TestPriceUnsettled(t *testing.T) {
+	input := CreateTestInput(t)
+	require.False(t, input.OracleKeeper.IsPriceUnsettled(input.Ctx, core.MicroSDRDenom))
+
+	input.OracleKeeper.SetUnsettledPeriods(input.Ctx, core.MicroSDRDenom, 2)
+	require.True(t, input.OracleKeeper.IsPriceUnsettled(input.Ctx, core.MicroSDRDenom))
+	require.False(t, input.OracleKeeper.IsPriceUnsettled(input.Ctx, core.MicroKRWDenom))
+
+	input.OracleKeeper.SetUnsettledPeriods(input.Ctx, core.MicroSDRDenom, 0)
+	require.False(t, input.OracleKeeper.IsPriceUnsettled(input.Ctx, core.MicroSDRDenom))
+}
+
+func TestApplyPriceBand(t *testing.T) {
+	lastPrice := sdk.NewDec(100)
+	tests := []struct {
+		mode        string
+		price       sdk.Dec
+		bandedPrice sdk.Dec
+		unsettled   bool
+	}{
+		{types.PriceBandModeClamp, sdk.NewDec(105), sdk.NewDec(105), false},
+		{types.PriceBandModeClamp, sdk.NewDec(90), sdk.NewDec(90), false},
+		{types.PriceBandModeClamp, sdk.NewDec(190), sdk.NewDec(110), true},
+		{types.PriceBandModeClamp, sdk.NewDec(10), sdk.NewDec(90), true},
+		{types.PriceBandModeReject, sdk.NewDec(105), sdk.NewDec(105), false},
+		{types.PriceBandModeReject, sdk.NewDec(190), lastPrice, true},
+		{types.PriceBandModeReject, sdk.NewDec(10), lastPrice, true},
+	}
+
+	for i, tc := range tests {
+		input := CreateTestInput(t)
+		params := input.OracleKeeper.GetParams(input.Ctx)
+		params.MaxPriceChange = sdk.NewDecWithPrec(1, 1)
+		params.PriceBandMode = tc.mode
+		input.OracleKeeper.SetParams(input.Ctx, params)
+
+		input.OracleKeeper.SetLunaPrice(input.Ctx.WithBlockHeight(1), core.MicroSDRDenom, lastPrice)
+
+		ctx := input.Ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+		bandedPrice, rejected := input.OracleKeeper.ApplyPriceBand(ctx, core.MicroSDRDenom, tc.price)
+		require.Equal(t, tc.bandedPrice, bandedPrice, "test: %v", i)
+		require.Equal(t, tc.unsettled && tc.mode == types.PriceBandModeReject, rejected, "test: %v", i)
+		require.Equal(t, tc.unsettled, input.OracleKeeper.IsPriceUnsettled(ctx, core.MicroSDRDenom), "test: %v", i)
+
+		events := ctx.EventManager().Events()
+		if tc.unsettled {
+			require.Equal(t, 1, len(events), "test: %v", i)
+			require.Equal(t, types.EventTypePriceBand, events[0].Type, "test: %v", i)
+		} else {
+			require.Equal(t, 0, len(events), "test: %v", i)
+		}
+	}
+}
+
+func TestApplyPriceBandDisabled(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// No price band without a last price
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.MaxPriceChange = sdk.NewDecWithPrec(1, 1)
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	price, _ := input.OracleKeeper.ApplyPriceBand(input.Ctx, core.MicroSDRDenom, sdk.NewDec(100))
+	require.Equal(t, sdk.NewDec(100), price)
+
+	// No price band with a zero MaxPriceChange
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, sdk.NewDec(100))
+	input.OracleKeeper.SetUnsettledPeriods(input.Ctx, core.MicroSDRDenom, 1)
+	params.MaxPriceChange = sdk.ZeroDec()
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	price, _ = input.OracleKeeper.ApplyPriceBand(input.Ctx, core.MicroSDRDenom, sdk.NewDec(1000))
+	require.Equal(t, sdk.NewDec(1000), price)
+	require.False(t, input.OracleKeeper.IsPriceUnsettled(input.Ctx, core.MicroSDRDenom))
+}
+
+func TestApplyPriceBandLastingMove(t *testing.T) {
+	input := CreateTestInput(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.MaxPriceChange = sdk.NewDecWithPrec(1, 1)
+	params.PriceBandMode = types.PriceBandModeReject
+	params.MaxUnsettledPeriods = 3
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	lastPrice := sdk.NewDec(100)
+	movedPrice := sdk.NewDec(190)
+	input.OracleKeeper.SetLunaPrice(input.Ctx.WithBlockHeight(1), core.MicroSDRDenom, lastPrice)
+
+	// the moved price is held off for MaxUnsettledPeriods tallies
+	height := int64(2)
+	for ; height < 2+params.MaxUnsettledPeriods; height++ {
+		ctx := input.Ctx.WithBlockHeight(height)
+		price, rejected := input.OracleKeeper.ApplyPriceBand(ctx, core.MicroSDRDenom, movedPrice)
+		require.Equal(t, lastPrice, price)
+		require.True(t, rejected)
+		require.True(t, input.OracleKeeper.IsPriceUnsettled(ctx, core.MicroSDRDenom))
+		require.Equal(t, height-1, input.OracleKeeper.GetUnsettledPeriods(ctx, core.MicroSDRDenom))
+	}
+
+	// then accepted, and the denom settles
+	ctx := input.Ctx.WithBlockHeight(height)
+	price, rejected := input.OracleKeeper.ApplyPriceBand(ctx, core.MicroSDRDenom, movedPrice)
+	require.Equal(t, movedPrice, price)
+	require.False(t, rejected)
+	require.False(t, input.OracleKeeper.IsPriceUnsettled(ctx, core.MicroSDRDenom))
+	input.OracleKeeper.SetLunaPrice(ctx, core.MicroSDRDenom, movedPrice)
+
+	ctx = input.Ctx.WithBlockHeight(height + 1)
+	price, _ = input.OracleKeeper.ApplyPriceBand(ctx, core.MicroSDRDenom, movedPrice)
+	require.Equal(t, movedPrice, price)
+	require.False(t, input.OracleKeeper.IsPriceUnsettled(ctx, core.MicroSDRDenom))
+
+	// a tally back within the band resets the count
+	input.OracleKeeper.SetUnsettledPeriods(ctx, core.MicroSDRDenom, 2)
+	price, _ = input.OracleKeeper.ApplyPriceBand(ctx, core.MicroSDRDenom, movedPrice)
+	require.Equal(t, movedPrice, price)
+	require.Equal(t, int64(0), input.OracleKeeper.GetUnsettledPeriods(ctx, core.MicroSDRDenom))
+}
+
+func TestApplyPriceBandStalePrice(t *testing.T) {
+	input := CreateTestInput(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 1
+	params.MaxPriceChange = sdk.NewDecWithPrec(1, 1)
+	params.PriceBandMode = types.PriceBandModeReject
+	params.MaxUnsettledPeriods = 3
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	lastPrice := sdk.NewDec(100)
+	movedPrice := sdk.NewDec(190)
+	input.OracleKeeper.SetLunaPrice(input.Ctx.WithBlockHeight(1), core.MicroSDRDenom, lastPrice)
+
+	// the last price is banded against while tallied within MaxUnsettledPeriods vote periods
+	ctx := input.Ctx.WithBlockHeight(1 + params.MaxUnsettledPeriods)
+	price, rejected := input.OracleKeeper.ApplyPriceBand(ctx, core.MicroSDRDenom, movedPrice)
+	require.Equal(t, lastPrice, price)
+	require.True(t, rejected)
+
+	// past that, the tallies of the denom failed for too long to band against it
+	ctx = input.Ctx.WithBlockHeight(2 + params.MaxUnsettledPeriods)
+	price, rejected = input.OracleKeeper.ApplyPriceBand(ctx, core.MicroSDRDenom, movedPrice)
+	require.Equal(t, movedPrice, price)
+	require.False(t, rejected)
+	require.False(t, input.OracleKeeper.IsPriceUnsettled(ctx, core.MicroSDRDenom))
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/oracle/internal/types"
+)
+
+// RecordPriceSnapshot appends the price of the denom at the current height to the denom's
+// ring buffer, overwriting the oldest snapshot once PriceHistoryRetention snapshots are stored.
+func (k Keeper) RecordPriceSnapshot(ctx sdk.Context, denom string, price sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	retention := k.PriceHistoryRetention(ctx)
+
+	index := k.getPriceHistoryIndex(ctx, denom)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(types.NewPriceSnapshot(ctx.BlockHeight(), price))
+	store.Set(types.GetPriceHistoryKey(denom, index%retention), bz)
+	k.setPriceHistoryIndex(ctx, denom, index+1)
+
+	// Drop the slots left over from a larger retention
+	var staleKeys [][]byte
+	iter := store.Iterator(types.GetPriceHistoryKey(denom, retention), sdk.PrefixEndBytes(types.GetPriceHistoryPrefixKey(denom)))
+	for ; iter.Valid(); iter.Next() {
+		staleKeys = append(staleKeys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+}
+
+// GetPriceHistory returns the stored price snapshots of the denom, latest first
+func (k Keeper) GetPriceHistory(ctx sdk.Context, denom string) (history types.PriceSnapshots) {
+	history = types.PriceSnapshots{}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPriceHistoryPrefixKey(denom))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var snapshot types.PriceSnapshot
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &snapshot)
+		history = append(history, snapshot)
+	}
+
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].Height > history[j].Height
+	})
+
+	return
+}
+
+// GetHistoricalLunaPrice returns the consensus price of Luna in the denom which was effective at the height;
+// the price of the latest tally at or before the height, unless it was dropped by a following tally.
+func (k Keeper) GetHistoricalLunaPrice(ctx sdk.Context, denom string, height int64) (price sdk.Dec, err sdk.Error) {
+	if denom == core.MicroLunaDenom {
+		return sdk.OneDec(), nil
+	}
+
+	votePeriod := k.VotePeriod(ctx)
+	for _, snapshot := range k.GetPriceHistory(ctx, denom) {
+		if snapshot.Height > height {
+			continue
+		}
+
+		if height >= snapshot.Height+votePeriod {
+			break
+		}
+
+		return snapshot.Price, nil
+	}
+
+	return sdk.ZeroDec(), types.ErrNoPriceHistory(k.codespace, denom, height)
+}
+
+// GetTWAP returns the time weighted average price of Luna in the denom over the last periods vote periods.
+// Each snapshot is weighted by the number of blocks it stayed effective, which is one vote period
+// unless a newer snapshot replaced it earlier.
+func (k Keeper) GetTWAP(ctx sdk.Context, denom string, periods int64) (twap sdk.Dec, err sdk.Error) {
+	if denom == core.MicroLunaDenom {
+		return sdk.OneDec(), nil
+	}
+
+	votePeriod := k.VotePeriod(ctx)
+	windowStart := ctx.BlockHeight() - periods*votePeriod
+
+	weightedSum := sdk.ZeroDec()
+	totalWeight := int64(0)
+	nextHeight := int64(-1)
+	for _, snapshot := range k.GetPriceHistory(ctx, denom) {
+		if snapshot.Height <= windowStart {
+			break
+		}
+
+		weight := votePeriod
+		if nextHeight >= 0 && nextHeight-snapshot.Height < weight {
+			weight = nextHeight - snapshot.Height
+		}
+
+		weightedSum = weightedSum.Add(snapshot.Price.MulInt64(weight))
+		totalWeight += weight
+		nextHeight = snapshot.Height
+	}
+
+	if totalWeight == 0 {
+		return sdk.ZeroDec(), types.ErrNoPriceHistory(k.codespace, denom, ctx.BlockHeight())
+	}
+
+	return weightedSum.QuoInt64(totalWeight), nil
+}
+
+// getPriceHistoryIndex returns the number of snapshots ever recorded for the denom
+func (k Keeper) getPriceHistoryIndex(ctx sdk.Context, denom string) (index int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPriceHistoryIndexKey(denom))
+	if bz == nil {
+		return 0
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &index)
+	return
+}
+
+func (k Keeper) setPriceHistoryIndex(ctx sdk.Context, denom string, index int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(index)
+	store.Set(types.GetPriceHistoryIndexKey(denom), bz)
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+)
+
+func setPriceHistoryParams(input TestInput, votePeriod, retention int64) {
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = votePeriod
+	params.PriceHistoryRetention = retention
+	input.OracleKeeper.SetParams(input.Ctx, params)
+}
+
+func TestPriceHistoryRingBuffer(t *testing.T) {
+	input := CreateTestInput(t)
+	setPriceHistoryParams(input, 10, 3)
+
+	for i := int64(1); i <= 5; i++ {
+		input.OracleKeeper.RecordPriceSnapshot(input.Ctx.WithBlockHeight(i*10), core.MicroSDRDenom, sdk.NewDec(i))
+	}
+
+	// Only the latest snapshots within the retention are kept, latest first
+	history := input.OracleKeeper.GetPriceHistory(input.Ctx, core.MicroSDRDenom)
+	require.Equal(t, 3, len(history))
+	for i, snapshot := range history {
+		require.Equal(t, int64(50-i*10), snapshot.Height)
+		require.Equal(t, sdk.NewDec(int64(5-i)), snapshot.Price)
+	}
+
+	// Other denoms have their own buffer
+	require.Equal(t, 0, len(input.OracleKeeper.GetPriceHistory(input.Ctx, core.MicroKRWDenom)))
+
+	// Shrinking the retention drops the leftover slots
+	setPriceHistoryParams(input, 10, 2)
+	input.OracleKeeper.RecordPriceSnapshot(input.Ctx.WithBlockHeight(60), core.MicroSDRDenom, sdk.NewDec(6))
+
+	history = input.OracleKeeper.GetPriceHistory(input.Ctx, core.MicroSDRDenom)
+	require.Equal(t, 2, len(history))
+	require.Equal(t, int64(60), history[0].Height)
+}
+
+func TestHistoricalLunaPrice(t *testing.T) {
+	input := CreateTestInput(t)
+	setPriceHistoryParams(input, 10, 10)
+
+	input.OracleKeeper.RecordPriceSnapshot(input.Ctx.WithBlockHeight(10), core.MicroSDRDenom, sdk.NewDec(1))
+	input.OracleKeeper.RecordPriceSnapshot(input.Ctx.WithBlockHeight(20), core.MicroSDRDenom, sdk.NewDec(2))
+	// ballot failed at height 30
+	input.OracleKeeper.RecordPriceSnapshot(input.Ctx.WithBlockHeight(40), core.MicroSDRDenom, sdk.NewDec(4))
+
+	price, err := input.OracleKeeper.GetHistoricalLunaPrice(input.Ctx, core.MicroSDRDenom, 15)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1), price)
+
+	price, err = input.OracleKeeper.GetHistoricalLunaPrice(input.Ctx, core.MicroSDRDenom, 20)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2), price)
+
+	_, err = input.OracleKeeper.GetHistoricalLunaPrice(input.Ctx, core.MicroSDRDenom, 35)
+	require.Error(t, err)
+
+	_, err = input.OracleKeeper.GetHistoricalLunaPrice(input.Ctx, core.MicroSDRDenom, 5)
+	require.Error(t, err)
+
+	price, err = input.OracleKeeper.GetHistoricalLunaPrice(input.Ctx, core.MicroLunaDenom, 5)
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec(), price)
+}
+
+func TestTWAP(t *testing.T) {
+	input := CreateTestInput(t)
+	setPriceHistoryParams(input, 10, 10)
+
+	input.OracleKeeper.RecordPriceSnapshot(input.Ctx.WithBlockHeight(10), core.MicroSDRDenom, sdk.NewDec(100))
+	input.OracleKeeper.RecordPriceSnapshot(input.Ctx.WithBlockHeight(20), core.MicroSDRDenom, sdk.NewDec(200))
+	input.OracleKeeper.RecordPriceSnapshot(input.Ctx.WithBlockHeight(30), core.MicroSDRDenom, sdk.NewDec(600))
+
+	ctx := input.Ctx.WithBlockHeight(30)
+
+	twap, err := input.OracleKeeper.GetTWAP(ctx, core.MicroSDRDenom, 1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(600), twap)
+
+	twap, err = input.OracleKeeper.GetTWAP(ctx, core.MicroSDRDenom, 2)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(400), twap)
+
+	twap, err = input.OracleKeeper.GetTWAP(ctx, core.MicroSDRDenom, 100)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(300), twap)
+
+	// A snapshot replaced before the end of its vote period weights less
+	setPriceHistoryParams(input, 20, 10)
+	input.OracleKeeper.RecordPriceSnapshot(input.Ctx.WithBlockHeight(40), core.MicroSDRDenom, sdk.NewDec(300))
+	twap, err = input.OracleKeeper.GetTWAP(input.Ctx.WithBlockHeight(40), core.MicroSDRDenom, 1)
+	require.NoError(t, err)
+	// 600 * 10 blocks + 300 * 20 blocks over 30 blocks
+	require.Equal(t, sdk.NewDec(400), twap)
+
+	// No snapshot in the window
+	_, err = input.OracleKeeper.GetTWAP(input.Ctx.WithBlockHeight(1000), core.MicroSDRDenom, 1)
+	require.Error(t, err)
+}
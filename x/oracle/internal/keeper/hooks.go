@@ -15,6 +15,7 @@ func (k Keeper) AfterValidatorBonded(ctx sdk.Context, _ sdk.ConsAddress, address
 			ctx.BlockHeight(),
 			0,
 			0,
+			0,
 		)
 		k.SetVotingInfo(ctx, address, votingInfo)
 	}
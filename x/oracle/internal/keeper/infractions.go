@@ -4,22 +4,26 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
 	"github.com/terra-project/core/x/oracle/internal/types"
 )
 
 // HandleWrongVotes handles a wrong votes, must be called once per validator per voting period.
-func (k Keeper) HandleBallotSlashing(ctx sdk.Context, ballotAttendees map[string]bool) {
+// ballotAbstainers holds the validators who abstained on at least one denom of the period.
+func (k Keeper) HandleBallotSlashing(ctx sdk.Context, ballotAttendees map[string]bool, ballotAbstainers map[string]bool) {
 	for addr, valid := range ballotAttendees {
 		valAddr, err := sdk.ValAddressFromBech32(addr)
 		if err != nil {
 			panic(err) // NOTE never occurs
 		}
 
-		k.handleBallotSlashing(ctx, valAddr, valid)
+		k.handleBallotSlashing(ctx, valAddr, valid, ballotAbstainers[addr])
 	}
 }
 
-func (k Keeper) handleBallotSlashing(ctx sdk.Context, valAddr sdk.ValAddress, valid bool) {
+func (k Keeper) handleBallotSlashing(ctx sdk.Context, valAddr sdk.ValAddress, valid bool, abstained bool) {
 	logger := k.Logger(ctx)
 	height := ctx.BlockHeight()
 
@@ -29,11 +33,39 @@ func (k Keeper) handleBallotSlashing(ctx sdk.Context, valAddr sdk.ValAddress, va
 		panic(fmt.Sprintf("Expected signing info for validator %s but not found", valAddr))
 	}
 
+	// A validator jailed by the oracle can only be back in the validator set early if it was
+	// unjailed through another module; jail it again until its oracle jail duration has elapsed.
+	if votingInfo.Jailed {
+		if ctx.BlockHeader().Time.Before(votingInfo.JailedUntil) {
+			if validator := k.StakingKeeper.Validator(ctx, valAddr); validator != nil && !validator.IsJailed() {
+				logger.Info(fmt.Sprintf("Validator %s is jailed by the oracle until %s, jailing it again", valAddr, votingInfo.JailedUntil))
+				k.StakingKeeper.Jail(ctx, validator.GetConsAddr())
+			}
+			return
+		}
+
+		votingInfo.Jailed = false
+	}
+
 	// this is a relative index, so it counts blocks the validator *should* have signed
 	// will use the 0-value default signing info if not present, except for start height
 	index := votingInfo.IndexOffset % k.VotesWindow(ctx)
 	votingInfo.IndexOffset++
 
+	// The abstain budget is renewed at the beginning of every votes window
+	if index == 0 {
+		votingInfo.AbstainVotesCounter = 0
+	}
+
+	// Abstains count as valid votes only within the abstain budget
+	if valid && abstained {
+		if votingInfo.AbstainVotesCounter < k.AbstainBudgetPerWindow(ctx) {
+			votingInfo.AbstainVotesCounter++
+		} else {
+			valid = false
+		}
+	}
+
 	// Update signed block bit array & counter
 	// This counter just tracks the sum of the bit array
 	// That way we avoid needing to read/write the whole array each time
@@ -91,13 +123,20 @@ func (k Keeper) handleBallotSlashing(ctx sdk.Context, valAddr sdk.ValAddress, va
 					types.EventTypeSlash,
 					sdk.NewAttribute(types.AttributeKeyAddress, valAddr.String()),
 					sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+					sdk.NewAttribute(types.AttributeKeyJailed, valAddr.String()),
 				),
 			)
 
 			k.StakingKeeper.Slash(ctx, validator.GetConsAddr(), distributionHeight, power, k.SlashFraction(ctx))
+			k.StakingKeeper.Jail(ctx, validator.GetConsAddr())
+
+			// Keep the validator out of the validator set until the jail duration has elapsed
+			votingInfo.Jailed = true
+			votingInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.JailDuration(ctx))
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			votingInfo.MissedVotesCounter = 0
+			votingInfo.AbstainVotesCounter = 0
 			votingInfo.IndexOffset = 0
 			k.clearMissedVoteBitArray(ctx, valAddr)
 		} else {
@@ -111,3 +150,74 @@ func (k Keeper) handleBallotSlashing(ctx sdk.Context, valAddr sdk.ValAddress, va
 	// Set the updated signing info
 	k.SetVotingInfo(ctx, valAddr, votingInfo)
 }
+
+// getSlashingSigningInfo returns the signing info of the validator in the slashing module
+func (k Keeper) getSlashingSigningInfo(ctx sdk.Context, consAddr sdk.ConsAddress) (info slashing.ValidatorSigningInfo, found bool) {
+	k.slashingKeeper.IterateValidatorSigningInfos(ctx, func(address sdk.ConsAddress, i slashing.ValidatorSigningInfo) (stop bool) {
+		if address.Equals(consAddr) {
+			info, found = i, true
+			return true
+		}
+		return false
+	})
+
+	return
+}
+
+// Unjail lifts the oracle jail of the validator once the jail duration has elapsed, and unjails
+// the validator in the staking module, following the unjail semantics of the slashing module.
+// A tombstoned validator cannot be unjailed; a validator still jailed by the slashing module
+// has only its oracle jail lifted, and is left to be unjailed with the slashing MsgUnjail.
+func (k Keeper) Unjail(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Error {
+	validator := k.StakingKeeper.Validator(ctx, valAddr)
+	if validator == nil {
+		return staking.ErrNoValidatorFound(k.codespace)
+	}
+
+	// cannot be unjailed if no self-delegation exists
+	selfDel := k.StakingKeeper.Delegation(ctx, sdk.AccAddress(valAddr), valAddr)
+	if selfDel == nil {
+		return types.ErrMissingSelfDelegation(k.codespace)
+	}
+
+	if validator.TokensFromShares(selfDel.GetShares()).TruncateInt().LT(validator.GetMinSelfDelegation()) {
+		return types.ErrSelfDelegationTooLowToUnjail(k.codespace)
+	}
+
+	votingInfo, found := k.getVotingInfo(ctx, valAddr)
+	if !found {
+		return types.ErrNoVotingInfoFound(k.codespace, valAddr)
+	}
+
+	// cannot be unjailed if not jailed by the oracle
+	if !votingInfo.Jailed {
+		return types.ErrValidatorNotJailed(k.codespace, valAddr)
+	}
+
+	// cannot be unjailed until the jail duration has elapsed
+	if ctx.BlockHeader().Time.Before(votingInfo.JailedUntil) {
+		return types.ErrValidatorJailed(k.codespace, valAddr, votingInfo.JailedUntil)
+	}
+
+	// cannot be unjailed if tombstoned for double signing
+	signingInfo, found := k.getSlashingSigningInfo(ctx, validator.GetConsAddr())
+	if found && signingInfo.Tombstoned {
+		return types.ErrValidatorTombstoned(k.codespace, valAddr)
+	}
+
+	votingInfo.Jailed = false
+	k.SetVotingInfo(ctx, valAddr, votingInfo)
+
+	// the validator may have been unjailed through another module meanwhile
+	if !validator.IsJailed() {
+		return nil
+	}
+
+	// the validator stays jailed until its slashing jail duration has elapsed as well
+	if found && ctx.BlockHeader().Time.Before(signingInfo.JailedUntil) {
+		return nil
+	}
+
+	k.StakingKeeper.Unjail(ctx, validator.GetConsAddr())
+	return nil
+}
@@ -2,11 +2,15 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/terra-project/core/x/oracle/internal/types"
 )
 
 // Test a new validator entering the validator set
@@ -35,11 +39,11 @@ func TestHandleNewValidator(t *testing.T) {
 	// The validator miss one vote
 	ballotAttendees := make(map[string]bool)
 	ballotAttendees[addr.String()] = true
-	input.OracleKeeper.HandleBallotSlashing(ctx, ballotAttendees)
+	input.OracleKeeper.HandleBallotSlashing(ctx, ballotAttendees, map[string]bool{})
 
 	ctx = ctx.WithBlockHeight(input.OracleKeeper.VotesWindow(ctx) + 2)
 	ballotAttendees[addr.String()] = false
-	input.OracleKeeper.HandleBallotSlashing(ctx, ballotAttendees)
+	input.OracleKeeper.HandleBallotSlashing(ctx, ballotAttendees, map[string]bool{})
 
 	info, found := input.OracleKeeper.getVotingInfo(ctx, addr)
 	require.True(t, found)
@@ -75,7 +79,7 @@ func TestSlash(t *testing.T) {
 
 		ballotAttendees := make(map[string]bool)
 		ballotAttendees[addr.String()] = true
-		input.OracleKeeper.HandleBallotSlashing(ctx, ballotAttendees)
+		input.OracleKeeper.HandleBallotSlashing(ctx, ballotAttendees, map[string]bool{})
 	}
 
 	// shouldn't be slashed
@@ -89,7 +93,7 @@ func TestSlash(t *testing.T) {
 
 		ballotAttendees := make(map[string]bool)
 		ballotAttendees[addr.String()] = false
-		input.OracleKeeper.HandleBallotSlashing(ctx, ballotAttendees)
+		input.OracleKeeper.HandleBallotSlashing(ctx, ballotAttendees, map[string]bool{})
 	}
 
 	// shouldn't be slashed
@@ -101,7 +105,7 @@ func TestSlash(t *testing.T) {
 
 		ballotAttendees := make(map[string]bool)
 		ballotAttendees[addr.String()] = false
-		input.OracleKeeper.HandleBallotSlashing(ctx, ballotAttendees)
+		input.OracleKeeper.HandleBallotSlashing(ctx, ballotAttendees, map[string]bool{})
 	}
 
 	// must be slashed
@@ -109,3 +113,170 @@ func TestSlash(t *testing.T) {
 	slashFraction := input.OracleKeeper.SlashFraction(ctx)
 	require.Equal(t, sdk.OneDec().Sub(slashFraction).MulInt(expTokens).TruncateInt(), validator.GetBondedTokens())
 }
+
+// Test abstains count as valid votes only within the abstain budget of a votes window
+func TestHandleAbstainBudget(t *testing.T) {
+	input := CreateTestInput(t)
+	addr := ValAddrs[0]
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotesWindow = 10
+	params.AbstainBudgetPerWindow = sdk.NewDecWithPrec(2, 1) // 2 abstains per window
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.SetVotingInfo(input.Ctx, addr, types.NewVotingInfo(addr, 0, 0, 0, 0))
+
+	ballotAttendees := map[string]bool{addr.String(): true}
+	ballotAbstainers := map[string]bool{addr.String(): true}
+
+	// Third abstain exceeds the budget
+	for height := int64(0); height < 3; height++ {
+		input.OracleKeeper.HandleBallotSlashing(input.Ctx.WithBlockHeight(height), ballotAttendees, ballotAbstainers)
+	}
+
+	votingInfo, found := input.OracleKeeper.getVotingInfo(input.Ctx, addr)
+	require.True(t, found)
+	require.Equal(t, int64(2), votingInfo.AbstainVotesCounter)
+	require.Equal(t, int64(1), votingInfo.MissedVotesCounter)
+	require.False(t, input.OracleKeeper.GetMissedVoteBitArray(input.Ctx, addr, 1))
+	require.True(t, input.OracleKeeper.GetMissedVoteBitArray(input.Ctx, addr, 2))
+
+	// Budget is renewed at the next window
+	for height := int64(3); height < 11; height++ {
+		input.OracleKeeper.HandleBallotSlashing(input.Ctx.WithBlockHeight(height), ballotAttendees, map[string]bool{})
+	}
+	input.OracleKeeper.HandleBallotSlashing(input.Ctx.WithBlockHeight(11), ballotAttendees, ballotAbstainers)
+
+	votingInfo, _ = input.OracleKeeper.getVotingInfo(input.Ctx, addr)
+	require.Equal(t, int64(1), votingInfo.AbstainVotesCounter)
+	require.False(t, input.OracleKeeper.GetMissedVoteBitArray(input.Ctx, addr, 1))
+}
+
+// Test a validator missing too many votes is jailed, and can be unjailed once the jail duration has elapsed
+func TestHandleJailAndUnjail(t *testing.T) {
+	input := CreateTestInput(t)
+	addr, val := ValAddrs[0], PubKeys[0]
+	amt := sdk.TokensFromConsensusPower(100)
+	sk := input.StakingKeeper
+	sh := staking.NewHandler(sk)
+	ctx := input.Ctx.WithBlockTime(time.Unix(1000, 0))
+
+	got := sh(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	params := input.OracleKeeper.GetParams(ctx)
+	params.VotesWindow = 20
+	input.OracleKeeper.SetParams(ctx, params)
+
+	// Nothing to unjail yet
+	err := input.OracleKeeper.Unjail(ctx, addr)
+	require.Error(t, err)
+	require.Equal(t, types.CodeValidatorNotJailed, err.Code())
+
+	// miss every vote until slashed
+	height := int64(0)
+	for ; !sk.Validator(ctx, addr).IsJailed(); height++ {
+		require.True(t, height <= 2*params.VotesWindow)
+		ballotAttendees := map[string]bool{addr.String(): false}
+		input.OracleKeeper.HandleBallotSlashing(ctx.WithBlockHeight(height), ballotAttendees, map[string]bool{})
+	}
+
+	votingInfo, found := input.OracleKeeper.getVotingInfo(ctx, addr)
+	require.True(t, found)
+	require.True(t, votingInfo.Jailed)
+	require.Equal(t, ctx.BlockHeader().Time.Add(params.JailDuration), votingInfo.JailedUntil)
+
+	// Unjailed through another module before the jail duration has elapsed; jailed again without being slashed twice
+	tokens := sk.Validator(ctx, addr).GetBondedTokens()
+	sk.Unjail(ctx, sk.Validator(ctx, addr).GetConsAddr())
+	ballotAttendees := map[string]bool{addr.String(): false}
+	input.OracleKeeper.HandleBallotSlashing(ctx.WithBlockHeight(height), ballotAttendees, map[string]bool{})
+	require.True(t, sk.Validator(ctx, addr).IsJailed())
+	require.Equal(t, tokens, sk.Validator(ctx, addr).GetBondedTokens())
+
+	// Cannot be unjailed before the jail duration has elapsed
+	err = input.OracleKeeper.Unjail(ctx, addr)
+	require.Error(t, err)
+	require.Equal(t, types.CodeValidatorJailed, err.Code())
+
+	ctx = ctx.WithBlockTime(votingInfo.JailedUntil)
+	require.Nil(t, input.OracleKeeper.Unjail(ctx, addr))
+	require.False(t, sk.Validator(ctx, addr).IsJailed())
+
+	votingInfo, _ = input.OracleKeeper.getVotingInfo(ctx, addr)
+	require.False(t, votingInfo.Jailed)
+}
+
+// Test a validator jailed by both the oracle and the slashing module is left to the slashing module to be unjailed
+func TestUnjailJailedBySlashing(t *testing.T) {
+	input := CreateTestInput(t)
+	addr, val := ValAddrs[0], PubKeys[0]
+	amt := sdk.TokensFromConsensusPower(100)
+	sk := input.StakingKeeper
+	sh := staking.NewHandler(sk)
+	ctx := input.Ctx.WithBlockTime(time.Unix(1000, 0))
+
+	got := sh(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	// jailed by the oracle
+	consAddr := sk.Validator(ctx, addr).GetConsAddr()
+	sk.Jail(ctx, consAddr)
+	jailedUntil := ctx.BlockHeader().Time.Add(time.Hour)
+	votingInfo := types.NewVotingInfo(addr, 0, 0, 0, 0)
+	votingInfo.Jailed = true
+	votingInfo.JailedUntil = jailedUntil
+	input.OracleKeeper.SetVotingInfo(ctx, addr, votingInfo)
+
+	// and by the slashing module, for longer
+	signingInfo := slashing.NewValidatorSigningInfo(consAddr, 0, 0, jailedUntil.Add(time.Hour), false, 0)
+	input.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, signingInfo)
+
+	// the oracle jail is lifted, but the validator stays jailed
+	ctx = ctx.WithBlockTime(jailedUntil)
+	require.Nil(t, input.OracleKeeper.Unjail(ctx, addr))
+	require.True(t, sk.Validator(ctx, addr).IsJailed())
+
+	votingInfo, _ = input.OracleKeeper.getVotingInfo(ctx, addr)
+	require.False(t, votingInfo.Jailed)
+
+	// the oracle no longer jails the validator again once unjailed by the slashing module
+	ctx = ctx.WithBlockTime(signingInfo.JailedUntil)
+	res := slashing.NewHandler(input.SlashingKeeper)(ctx, slashing.NewMsgUnjail(addr))
+	require.True(t, res.IsOK(), res.Log)
+	input.OracleKeeper.HandleBallotSlashing(ctx, map[string]bool{addr.String(): true}, map[string]bool{})
+	require.False(t, sk.Validator(ctx, addr).IsJailed())
+}
+
+// Test a validator tombstoned by the slashing module cannot be unjailed by the oracle
+func TestUnjailTombstoned(t *testing.T) {
+	input := CreateTestInput(t)
+	addr, val := ValAddrs[0], PubKeys[0]
+	amt := sdk.TokensFromConsensusPower(100)
+	sk := input.StakingKeeper
+	sh := staking.NewHandler(sk)
+	ctx := input.Ctx.WithBlockTime(time.Unix(1000, 0))
+
+	got := sh(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	consAddr := sk.Validator(ctx, addr).GetConsAddr()
+	sk.Jail(ctx, consAddr)
+	votingInfo := types.NewVotingInfo(addr, 0, 0, 0, 0)
+	votingInfo.Jailed = true
+	votingInfo.JailedUntil = ctx.BlockHeader().Time
+	input.OracleKeeper.SetVotingInfo(ctx, addr, votingInfo)
+
+	signingInfo := slashing.NewValidatorSigningInfo(consAddr, 0, 0, slashing.DoubleSignJailEndTime, true, 0)
+	input.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, signingInfo)
+
+	err := input.OracleKeeper.Unjail(ctx, addr)
+	require.Error(t, err)
+	require.Equal(t, types.CodeValidatorTombstoned, err.Code())
+	require.True(t, sk.Validator(ctx, addr).IsJailed())
+
+	votingInfo, _ = input.OracleKeeper.getVotingInfo(ctx, addr)
+	require.True(t, votingInfo.Jailed)
+}
@@ -19,9 +19,10 @@ type Keeper struct {
 	storeKey   sdk.StoreKey
 	paramSpace params.Subspace
 
-	distrKeeper   types.DistributionKeeper
-	StakingKeeper types.StakingKeeper
-	supplyKeeper  types.SupplyKeeper
+	distrKeeper    types.DistributionKeeper
+	StakingKeeper  types.StakingKeeper
+	slashingKeeper types.SlashingKeeper
+	supplyKeeper   types.SupplyKeeper
 
 	distrName string
 
@@ -32,17 +33,18 @@ type Keeper struct {
 // NewKeeper constructs a new keeper for oracle
 func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey,
 	paramspace params.Subspace, distrKeeper types.DistributionKeeper,
-	stakingKeeper types.StakingKeeper, supplyKeeper types.SupplyKeeper,
+	stakingKeeper types.StakingKeeper, slashingKeeper types.SlashingKeeper, supplyKeeper types.SupplyKeeper,
 	distrName string, codespace sdk.CodespaceType) Keeper {
 	return Keeper{
-		cdc:           cdc,
-		storeKey:      storeKey,
-		paramSpace:    paramspace.WithKeyTable(ParamKeyTable()),
-		distrKeeper:   distrKeeper,
-		StakingKeeper: stakingKeeper,
-		supplyKeeper:  supplyKeeper,
-		distrName:     distrName,
-		codespace:     codespace,
+		cdc:            cdc,
+		storeKey:       storeKey,
+		paramSpace:     paramspace.WithKeyTable(ParamKeyTable()),
+		distrKeeper:    distrKeeper,
+		StakingKeeper:  stakingKeeper,
+		slashingKeeper: slashingKeeper,
+		supplyKeeper:   supplyKeeper,
+		distrName:      distrName,
+		codespace:      codespace,
 	}
 }
 
@@ -90,10 +92,25 @@ func (k Keeper) iteratePrevotesWithPrefix(ctx sdk.Context, prefix []byte, handle
 //-----------------------------------
 // Votes logic
 
-// collectVotes collects all oracle votes for the period, categorized by the votes' denom parameter
+// collectVotes collects all oracle votes for the period, categorized by the votes' denom parameter.
+// Aggregate votes are expanded into per-denom votes, and take precedence over a legacy
+// per-denom vote of the same voter on the same denom.
 func (k Keeper) CollectVotes(ctx sdk.Context) (votes map[string]types.PriceBallot) {
 	votes = map[string]types.PriceBallot{}
+	aggregated := map[string]bool{}
+	k.IterateAggregateVotes(ctx, func(aggregateVote types.AggregatePriceVote) (stop bool) {
+		for _, vote := range aggregateVote.PriceVotes() {
+			votes[vote.Denom] = append(votes[vote.Denom], vote)
+			aggregated[string(types.GetVoteKey(vote.Denom, vote.Voter))] = true
+		}
+		return false
+	})
+
 	handler := func(vote types.PriceVote) (stop bool) {
+		if aggregated[string(types.GetVoteKey(vote.Denom, vote.Voter))] {
+			return false
+		}
+
 		votes[vote.Denom] = append(votes[vote.Denom], vote)
 		return false
 	}
@@ -180,28 +197,136 @@ func (k Keeper) DeleteVote(ctx sdk.Context, vote types.PriceVote) {
 	store.Delete(types.GetVoteKey(vote.Denom, vote.Voter))
 }
 
+//-----------------------------------
+// Aggregate prevote & vote logic
+
+// IterateAggregatePrevotes iterates over aggregate prevotes in the store
+func (k Keeper) IterateAggregatePrevotes(ctx sdk.Context, handler func(aggregatePrevote types.AggregatePricePrevote) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AggregatePrevoteKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var aggregatePrevote types.AggregatePricePrevote
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &aggregatePrevote)
+		if handler(aggregatePrevote) {
+			break
+		}
+	}
+}
+
+// GetAggregatePrevote retrieves an aggregate prevote from the store
+func (k Keeper) GetAggregatePrevote(ctx sdk.Context, voter sdk.ValAddress) (aggregatePrevote types.AggregatePricePrevote, err sdk.Error) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetAggregatePrevoteKey(voter))
+	if b == nil {
+		err = types.ErrNoAggregatePrevote(k.codespace, voter)
+		return
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &aggregatePrevote)
+	return
+}
+
+// AddAggregatePrevote adds an aggregate prevote to the store
+func (k Keeper) AddAggregatePrevote(ctx sdk.Context, aggregatePrevote types.AggregatePricePrevote) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(aggregatePrevote)
+	store.Set(types.GetAggregatePrevoteKey(aggregatePrevote.Voter), bz)
+}
+
+// DeleteAggregatePrevote deletes an aggregate prevote from the store
+func (k Keeper) DeleteAggregatePrevote(ctx sdk.Context, aggregatePrevote types.AggregatePricePrevote) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAggregatePrevoteKey(aggregatePrevote.Voter))
+}
+
+// IterateAggregateVotes iterates over aggregate votes in the store
+func (k Keeper) IterateAggregateVotes(ctx sdk.Context, handler func(aggregateVote types.AggregatePriceVote) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AggregateVoteKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var aggregateVote types.AggregatePriceVote
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &aggregateVote)
+		if handler(aggregateVote) {
+			break
+		}
+	}
+}
+
+// GetAggregateVote retrieves an aggregate vote from the store
+func (k Keeper) GetAggregateVote(ctx sdk.Context, voter sdk.ValAddress) (aggregateVote types.AggregatePriceVote, err sdk.Error) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetAggregateVoteKey(voter))
+	if b == nil {
+		err = types.ErrNoAggregateVote(k.codespace, voter)
+		return
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &aggregateVote)
+	return
+}
+
+// AddAggregateVote adds an aggregate vote to the store
+func (k Keeper) AddAggregateVote(ctx sdk.Context, aggregateVote types.AggregatePriceVote) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(aggregateVote)
+	store.Set(types.GetAggregateVoteKey(aggregateVote.Voter), bz)
+}
+
+// DeleteAggregateVote deletes an aggregate vote from the store
+func (k Keeper) DeleteAggregateVote(ctx sdk.Context, aggregateVote types.AggregatePriceVote) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAggregateVoteKey(aggregateVote.Voter))
+}
+
 //-----------------------------------
 // Price logic
 
 // GetLunaPrice gets the consensus exchange rate of Luna denominated in the denom asset from the store.
 func (k Keeper) GetLunaPrice(ctx sdk.Context, denom string) (price sdk.Dec, err sdk.Error) {
+	info, err := k.GetLunaPriceInfo(ctx, denom)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	return info.Price, nil
+}
+
+// GetLunaPriceInfo gets the consensus exchange rate of Luna denominated in the denom asset from the store,
+// with the height and time it was tallied.
+func (k Keeper) GetLunaPriceInfo(ctx sdk.Context, denom string) (info types.PriceInfo, err sdk.Error) {
 	if denom == core.MicroLunaDenom {
-		return sdk.OneDec(), nil
+		return types.NewPriceInfo(sdk.OneDec(), ctx.BlockHeight(), ctx.BlockHeader().Time), nil
 	}
 
 	store := ctx.KVStore(k.storeKey)
 	b := store.Get(types.GetPriceKey(denom))
 	if b == nil {
-		return sdk.ZeroDec(), types.ErrUnknownDenomination(k.codespace, denom)
+		return types.PriceInfo{}, types.ErrUnknownDenomination(k.codespace, denom)
 	}
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &price)
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &info)
 	return
 }
 
-// SetLunaPrice sets the consensus exchange rate of Luna denominated in the denom asset to the store.
+// GetFreshLunaPrice gets the consensus exchange rate of Luna denominated in the denom asset from the store,
+// refusing a rate tallied more than maxAge vote periods ago. A maxAge of 1 accepts only the rate of the last tally.
+func (k Keeper) GetFreshLunaPrice(ctx sdk.Context, denom string, maxAge int64) (price sdk.Dec, err sdk.Error) {
+	info, err := k.GetLunaPriceInfo(ctx, denom)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	if ctx.BlockHeight()-info.Height > maxAge*k.VotePeriod(ctx) {
+		return sdk.ZeroDec(), types.ErrStalePrice(k.codespace, denom, info.Height)
+	}
+
+	return info.Price, nil
+}
+
+// SetLunaPrice sets the consensus exchange rate of Luna denominated in the denom asset to the store,
+// stamped with the current height and time.
 func (k Keeper) SetLunaPrice(ctx sdk.Context, denom string, price sdk.Dec) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshalBinaryLengthPrefixed(price)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(types.NewPriceInfo(price, ctx.BlockHeight(), ctx.BlockHeader().Time))
 	store.Set(types.GetPriceKey(denom), bz)
 }
 
@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -178,6 +179,43 @@ func TestPrice(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestFreshPrice(t *testing.T) {
+	input := CreateTestInput(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 5
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	now := time.Now().UTC()
+	ctx := input.Ctx.WithBlockHeight(4).WithBlockTime(now)
+	input.OracleKeeper.SetLunaPrice(ctx, core.MicroSDRDenom, sdk.NewDec(1700))
+
+	info, err := input.OracleKeeper.GetLunaPriceInfo(ctx, core.MicroSDRDenom)
+	require.NoError(t, err)
+	require.Equal(t, types.NewPriceInfo(sdk.NewDec(1700), 4, now), info)
+
+	// Fresh within maxAge vote periods of the tally
+	price, err := input.OracleKeeper.GetFreshLunaPrice(ctx.WithBlockHeight(9), core.MicroSDRDenom, 1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1700), price)
+
+	_, err = input.OracleKeeper.GetFreshLunaPrice(ctx.WithBlockHeight(10), core.MicroSDRDenom, 1)
+	require.Error(t, err)
+
+	_, err = input.OracleKeeper.GetFreshLunaPrice(ctx.WithBlockHeight(14), core.MicroSDRDenom, 2)
+	require.NoError(t, err)
+
+	_, err = input.OracleKeeper.GetFreshLunaPrice(ctx.WithBlockHeight(15), core.MicroSDRDenom, 2)
+	require.Error(t, err)
+
+	// Luna is always fresh, unknown denoms never are
+	price, err = input.OracleKeeper.GetFreshLunaPrice(ctx.WithBlockHeight(100), core.MicroLunaDenom, 1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec(), price)
+
+	_, err = input.OracleKeeper.GetFreshLunaPrice(ctx, core.MicroKRWDenom, 1)
+	require.Error(t, err)
+}
+
 func TestRewardPool(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -210,6 +248,8 @@ func TestParams(t *testing.T) {
 	minValidVotesPerWindow := sdk.NewDecWithPrec(1, 2)
 	slashFraction := sdk.NewDecWithPrec(5, 2)
 	rewardFraction := sdk.NewDecWithPrec(1, 2)
+	abstainBudgetPerWindow := sdk.NewDecWithPrec(5, 2)
+	maxPriceChange := sdk.NewDecWithPrec(1, 1)
 
 	// Should really test validateParams, but skipping because obvious
 	newParams := types.Params{
@@ -220,6 +260,8 @@ func TestParams(t *testing.T) {
 		MinValidVotesPerWindow: minValidVotesPerWindow,
 		SlashFraction:          slashFraction,
 		RewardFraction:         rewardFraction,
+		AbstainBudgetPerWindow: abstainBudgetPerWindow,
+		MaxPriceChange:         maxPriceChange,
 	}
 	input.OracleKeeper.SetParams(input.Ctx, newParams)
 
@@ -248,14 +290,14 @@ func TestVotingInfo(t *testing.T) {
 	require.False(t, found)
 
 	// register voting info
-	votingInfo := types.NewVotingInfo(ValAddrs[0], 7, 1, 32)
+	votingInfo := types.NewVotingInfo(ValAddrs[0], 7, 1, 32, 0)
 	input.OracleKeeper.SetVotingInfo(input.Ctx, ValAddrs[0], votingInfo)
 
 	KVotingInfo, found := input.OracleKeeper.getVotingInfo(input.Ctx, ValAddrs[0])
 	require.True(t, found)
 	require.Equal(t, votingInfo, KVotingInfo)
 
-	votingInfo2 := types.NewVotingInfo(ValAddrs[1], 1, 2, 3)
+	votingInfo2 := types.NewVotingInfo(ValAddrs[1], 1, 2, 3, 0)
 	input.OracleKeeper.SetVotingInfo(input.Ctx, ValAddrs[1], votingInfo2)
 
 	i := 0
@@ -292,3 +334,66 @@ func TestGetSetValidatorMissedBlockBitArray(t *testing.T) {
 	missed = input.OracleKeeper.GetMissedVoteBitArray(input.Ctx, ValAddrs[0], 0)
 	require.False(t, missed) // treat empty key as not missed
 }
+
+func TestAggregatePrevoteAddDelete(t *testing.T) {
+	input := CreateTestInput(t)
+
+	aggregatePrevote := types.NewAggregatePricePrevote("", sdk.ValAddress(Addrs[0]), 0)
+	input.OracleKeeper.AddAggregatePrevote(input.Ctx, aggregatePrevote)
+
+	KPrevote, err := input.OracleKeeper.GetAggregatePrevote(input.Ctx, sdk.ValAddress(Addrs[0]))
+	require.NoError(t, err)
+	require.Equal(t, aggregatePrevote, KPrevote)
+
+	input.OracleKeeper.DeleteAggregatePrevote(input.Ctx, aggregatePrevote)
+	_, err = input.OracleKeeper.GetAggregatePrevote(input.Ctx, sdk.ValAddress(Addrs[0]))
+	require.Error(t, err)
+}
+
+func TestAggregateVoteAddDelete(t *testing.T) {
+	input := CreateTestInput(t)
+
+	aggregateVote := types.NewAggregatePriceVote(types.PriceTuples{
+		types.NewPriceTuple(core.MicroSDRDenom, sdk.NewDec(1700)),
+		types.NewPriceTuple(core.MicroKRWDenom, sdk.NewDec(3000)),
+	}, sdk.ValAddress(Addrs[0]))
+	input.OracleKeeper.AddAggregateVote(input.Ctx, aggregateVote)
+
+	KVote, err := input.OracleKeeper.GetAggregateVote(input.Ctx, sdk.ValAddress(Addrs[0]))
+	require.NoError(t, err)
+	require.Equal(t, aggregateVote, KVote)
+
+	input.OracleKeeper.DeleteAggregateVote(input.Ctx, aggregateVote)
+	_, err = input.OracleKeeper.GetAggregateVote(input.Ctx, sdk.ValAddress(Addrs[0]))
+	require.Error(t, err)
+}
+
+func TestVoteCollectWithAggregate(t *testing.T) {
+	input := CreateTestInput(t)
+
+	price := sdk.NewDec(1700)
+
+	// Legacy vote of a voter who also submits an aggregate vote on the same denom
+	input.OracleKeeper.AddVote(input.Ctx, types.NewPriceVote(sdk.NewDec(1), core.MicroSDRDenom, sdk.ValAddress(Addrs[0])))
+
+	// Legacy vote of a voter without aggregate vote
+	vote := types.NewPriceVote(price, core.MicroSDRDenom, sdk.ValAddress(Addrs[1]))
+	input.OracleKeeper.AddVote(input.Ctx, vote)
+
+	input.OracleKeeper.AddAggregateVote(input.Ctx, types.NewAggregatePriceVote(types.PriceTuples{
+		types.NewPriceTuple(core.MicroSDRDenom, price),
+		types.NewPriceTuple(core.MicroKRWDenom, price),
+	}, sdk.ValAddress(Addrs[0])))
+
+	collectedVotes := input.OracleKeeper.CollectVotes(input.Ctx)
+
+	sdrBallot := collectedVotes[core.MicroSDRDenom]
+	require.Equal(t, 2, len(sdrBallot))
+	for _, v := range sdrBallot {
+		require.Equal(t, price, v.Price)
+	}
+	require.Contains(t, sdrBallot, vote)
+
+	krwBallot := collectedVotes[core.MicroKRWDenom]
+	require.Equal(t, types.PriceBallot{types.NewPriceVote(price, core.MicroKRWDenom, sdk.ValAddress(Addrs[0]))}, krwBallot)
+}
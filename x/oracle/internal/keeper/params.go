@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/terra-project/core/x/oracle/internal/types"
@@ -51,12 +54,95 @@ func (k Keeper) MinValidVotesPerWindow(ctx sdk.Context) (res int64) {
 	return minValidVotesPerWindow.MulInt64(signedBlocksWindow).RoundInt64()
 }
 
+// AbstainBudgetPerWindow
+func (k Keeper) AbstainBudgetPerWindow(ctx sdk.Context) (res int64) {
+	var abstainBudgetPerWindow sdk.Dec
+	k.paramSpace.Get(ctx, types.ParamStoreKeyAbstainBudgetPerWindow, &abstainBudgetPerWindow)
+	votesWindow := k.VotesWindow(ctx)
+
+	// NOTE: RoundInt64 will never panic as abstainBudgetPerWindow is less than 1.
+	return abstainBudgetPerWindow.MulInt64(votesWindow).RoundInt64()
+}
+
+// JailDuration
+func (k Keeper) JailDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyJailDuration, &res)
+	return
+}
+
 // SlashFraction
 func (k Keeper) SlashFraction(ctx sdk.Context) (res sdk.Dec) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeySlashFraction, &res)
 	return
 }
 
+// Whitelist
+func (k Keeper) Whitelist(ctx sdk.Context) (res types.DenomList) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyWhitelist, &res)
+	return
+}
+
+// IsWhitelisted returns whether the denom is on the whitelist of denoms the oracle tallies
+func (k Keeper) IsWhitelisted(ctx sdk.Context, denom string) bool {
+	return k.Whitelist(ctx).Contains(denom)
+}
+
+// TallyStrategies
+func (k Keeper) TallyStrategies(ctx sdk.Context) (res types.DenomTallyStrategies) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyTallyStrategies, &res)
+	return
+}
+
+// TallyStrategy returns the strategy tallying the ballots of the denom; the weighted median
+// unless another strategy is set for the denom
+func (k Keeper) TallyStrategy(ctx sdk.Context, denom string) types.TallyStrategy {
+	for _, dts := range k.TallyStrategies(ctx) {
+		if dts.Denom != denom {
+			continue
+		}
+
+		strategy, err := dts.TallyStrategy()
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("invalid tally strategy, falling back to the weighted median: %s", err))
+			break
+		}
+
+		return strategy
+	}
+
+	return types.WeightedMedianStrategy{}
+}
+
+// MaxPriceChange
+func (k Keeper) MaxPriceChange(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyMaxPriceChange, &res)
+	return
+}
+
+// PriceBandMode
+func (k Keeper) PriceBandMode(ctx sdk.Context) (res string) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyPriceBandMode, &res)
+	return
+}
+
+// MaxUnsettledPeriods
+func (k Keeper) MaxUnsettledPeriods(ctx sdk.Context) (res int64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyMaxUnsettledPeriods, &res)
+	return
+}
+
+// ReferenceDenom
+func (k Keeper) ReferenceDenom(ctx sdk.Context) (res string) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyReferenceDenom, &res)
+	return
+}
+
+// PriceHistoryRetention
+func (k Keeper) PriceHistoryRetention(ctx sdk.Context) (res int64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyPriceHistoryRetention, &res)
+	return
+}
+
 // GetParams returns the total set of oracle parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
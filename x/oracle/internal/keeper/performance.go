@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/oracle/internal/types"
+)
+
+// GetValidatorPerformance gets the oracle performance statistics of a validator
+func (k Keeper) GetValidatorPerformance(ctx sdk.Context, valAddr sdk.ValAddress) (performance types.ValidatorPerformance, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPerformanceKey(valAddr))
+	if bz == nil {
+		return types.NewValidatorPerformance(valAddr), false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &performance)
+	return performance, true
+}
+
+// SetValidatorPerformance sets the oracle performance statistics of a validator
+func (k Keeper) SetValidatorPerformance(ctx sdk.Context, performance types.ValidatorPerformance) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(performance)
+	store.Set(types.GetPerformanceKey(performance.Address), bz)
+}
+
+// IterateValidatorPerformances iterates over the stored ValidatorPerformance
+func (k Keeper) IterateValidatorPerformances(ctx sdk.Context, handler func(performance types.ValidatorPerformance) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PerformanceKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var performance types.ValidatorPerformance
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &performance)
+
+		if handler(performance) {
+			break
+		}
+	}
+}
+
+// RecordAbstention counts an abstain vote of the validator
+func (k Keeper) RecordAbstention(ctx sdk.Context, valAddr sdk.ValAddress) {
+	performance, _ := k.GetValidatorPerformance(ctx, valAddr)
+	performance.Abstentions++
+	k.SetValidatorPerformance(ctx, performance)
+}
+
+// RecordBallotPerformance records the outcome of a tallied ballot of the denom; voters among the
+// ballot winners won the ballot and the others lost it. The deviation of every vote is measured
+// from the median the ballot was tallied to, in the unit of the ballot (a cross rate for ballots
+// tallied on their cross rates to the reference denom).
+func (k Keeper) RecordBallotPerformance(ctx sdk.Context, denom string, pb types.PriceBallot, median sdk.Dec, ballotWinners types.ClaimPool) {
+	if len(pb) == 0 {
+		return
+	}
+
+	winners := make(map[string]bool)
+	for _, winner := range ballotWinners {
+		winners[winner.Recipient.String()] = true
+	}
+
+	for _, vote := range pb {
+		performance, _ := k.GetValidatorPerformance(ctx, vote.Voter)
+		if winners[vote.Voter.String()] {
+			performance.BallotsWon++
+		} else {
+			performance.BallotsLost++
+		}
+
+		performance.AddDeviation(denom, vote.Price.Sub(median).Abs())
+		k.SetValidatorPerformance(ctx, performance)
+	}
+}
+
+// recordReward adds the reward earned as a ballot winner to the performance of the validator
+func (k Keeper) recordReward(ctx sdk.Context, valAddr sdk.ValAddress, reward sdk.Coins) {
+	performance, _ := k.GetValidatorPerformance(ctx, valAddr)
+	performance.Rewards = performance.Rewards.Add(reward)
+	k.SetValidatorPerformance(ctx, performance)
+}
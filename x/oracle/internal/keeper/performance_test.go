@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/oracle/internal/types"
+)
+
+func TestValidatorPerformance(t *testing.T) {
+	input := CreateTestInput(t)
+
+	performance, found := input.OracleKeeper.GetValidatorPerformance(input.Ctx, ValAddrs[0])
+	require.False(t, found)
+	require.Equal(t, types.NewValidatorPerformance(ValAddrs[0]), performance)
+
+	input.OracleKeeper.RecordAbstention(input.Ctx, ValAddrs[0])
+	input.OracleKeeper.RecordAbstention(input.Ctx, ValAddrs[0])
+	input.OracleKeeper.RecordAbstention(input.Ctx, ValAddrs[1])
+
+	performance, found = input.OracleKeeper.GetValidatorPerformance(input.Ctx, ValAddrs[0])
+	require.True(t, found)
+	require.Equal(t, int64(2), performance.Abstentions)
+
+	var performances types.ValidatorPerformances
+	input.OracleKeeper.IterateValidatorPerformances(input.Ctx, func(performance types.ValidatorPerformance) (stop bool) {
+		performances = append(performances, performance)
+		return false
+	})
+	require.Equal(t, 2, len(performances))
+}
+
+func TestRecordBallotPerformance(t *testing.T) {
+	input := CreateTestInput(t)
+	sh := staking.NewHandler(input.StakingKeeper)
+	amt := sdk.TokensFromConsensusPower(100)
+	for i := 0; i < 3; i++ {
+		got := sh(input.Ctx, NewTestMsgCreateValidator(ValAddrs[i], PubKeys[i], amt))
+		require.True(t, got.IsOK())
+	}
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+
+	ballot := func(prices ...int64) (pb types.PriceBallot) {
+		for i, price := range prices {
+			pb = append(pb, types.NewPriceVote(sdk.NewDec(price), core.MicroSDRDenom, ValAddrs[i]))
+		}
+		return
+	}
+
+	// Both ballots are tallied to the vote of validator 1
+	winners := types.ClaimPool{types.NewClaim(100, ValAddrs[0]), types.NewClaim(100, ValAddrs[1])}
+	input.OracleKeeper.RecordBallotPerformance(input.Ctx, core.MicroSDRDenom, ballot(10, 11, 50), sdk.NewDec(11), winners)
+	input.OracleKeeper.RecordBallotPerformance(input.Ctx, core.MicroSDRDenom, ballot(8, 11, 60), sdk.NewDec(11), winners)
+
+	performance, _ := input.OracleKeeper.GetValidatorPerformance(input.Ctx, ValAddrs[0])
+	require.Equal(t, int64(2), performance.BallotsWon)
+	require.Equal(t, int64(0), performance.BallotsLost)
+	require.Equal(t, []types.DenomDeviation{types.NewDenomDeviation(core.MicroSDRDenom, 2, sdk.NewDec(2))}, performance.Deviations)
+
+	performance, _ = input.OracleKeeper.GetValidatorPerformance(input.Ctx, ValAddrs[2])
+	require.Equal(t, int64(0), performance.BallotsWon)
+	require.Equal(t, int64(2), performance.BallotsLost)
+	require.Equal(t, []types.DenomDeviation{types.NewDenomDeviation(core.MicroSDRDenom, 2, sdk.NewDec(44))}, performance.Deviations)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -30,6 +32,20 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryVotingInfo(ctx, req, keeper)
 		case types.QueryVotingInfos:
 			return queryVotingInfos(ctx, req, keeper)
+		case types.QueryAggregatePrevote:
+			return queryAggregatePrevote(ctx, req, keeper)
+		case types.QueryAggregateVote:
+			return queryAggregateVote(ctx, req, keeper)
+		case types.QueryHistoricalPrice:
+			return queryHistoricalPrice(ctx, req, keeper)
+		case types.QueryTWAP:
+			return queryTWAP(ctx, req, keeper)
+		case types.QueryPerformance:
+			return queryPerformance(ctx, req, keeper)
+		case types.QueryPerformances:
+			return queryPerformances(ctx, req, keeper)
+		case types.QueryBallotPreview:
+			return queryBallotPreview(ctx, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown oracle query endpoint")
 		}
@@ -43,12 +59,12 @@ func queryPrice(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 		return nil, sdk.ErrUnknownRequest(err.Error())
 	}
 
-	price, err := keeper.GetLunaPrice(ctx, params.Denom)
+	info, err := keeper.GetLunaPriceInfo(ctx, params.Denom)
 	if err != nil {
 		return nil, types.ErrUnknownDenomination(types.DefaultCodespace, params.Denom)
 	}
 
-	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, price)
+	bz, err2 := codec.MarshalJSONIndent(keeper.cdc, info)
 	if err2 != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err2.Error()))
 	}
@@ -222,3 +238,144 @@ func queryVotingInfos(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 
 	return res, nil
 }
+
+func queryAggregatePrevote(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAggregatePrevoteParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	aggregatePrevote, sdkErr := keeper.GetAggregatePrevote(ctx, params.Validator)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, aggregatePrevote)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryAggregateVote(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAggregateVoteParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	aggregateVote, sdkErr := keeper.GetAggregateVote(ctx, params.Validator)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, aggregateVote)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryHistoricalPrice(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryHistoricalPriceParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	price, sdkErr := keeper.GetHistoricalLunaPrice(ctx, params.Denom, params.Height)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, price)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryTWAP(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTWAPParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	if params.Periods <= 0 {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("periods must be positive, is %d", params.Periods))
+	}
+
+	twap, sdkErr := keeper.GetTWAP(ctx, params.Denom, params.Periods)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, twap)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryPerformance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryPerformanceParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	performance, found := k.GetValidatorPerformance(ctx, params.ValAddress)
+	if !found {
+		return nil, types.ErrNoPerformanceFound(types.DefaultCodespace, params.ValAddress)
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, performance)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryPerformances(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryPerformancesParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	var performances types.ValidatorPerformances
+
+	k.IterateValidatorPerformances(ctx, func(performance types.ValidatorPerformance) (stop bool) {
+		performances = append(performances, performance)
+		return false
+	})
+
+	start, end := client.Paginate(len(performances), params.Page, params.Limit, int(k.StakingKeeper.MaxValidators(ctx)))
+	if start < 0 || end < 0 {
+		performances = types.ValidatorPerformances{}
+	} else {
+		performances = performances[start:end]
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, performances)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+func queryBallotPreview(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	previews := keeper.PreviewBallots(ctx)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, previews)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -199,10 +199,12 @@ func TestQueryPrice(t *testing.T) {
 	res, err := querier(input.Ctx, []string{types.QueryPrice}, req)
 	require.NoError(t, err)
 
-	var rprice sdk.Dec
-	err = cdc.UnmarshalJSON(res, &rprice)
+	var rinfo types.PriceInfo
+	err = cdc.UnmarshalJSON(res, &rinfo)
 	require.NoError(t, err)
-	require.Equal(t, price, rprice)
+	require.Equal(t, price, rinfo.Price)
+	require.Equal(t, input.Ctx.BlockHeight(), rinfo.Height)
+	require.True(t, input.Ctx.BlockHeader().Time.Equal(rinfo.Time))
 }
 
 func TestQueryActives(t *testing.T) {
@@ -258,7 +260,7 @@ func TestQueryVotingInfo(t *testing.T) {
 	input := CreateTestInput(t)
 	querier := NewQuerier(input.OracleKeeper)
 
-	votingInfo := types.NewVotingInfo(ValAddrs[0], 7, 1, 32)
+	votingInfo := types.NewVotingInfo(ValAddrs[0], 7, 1, 32, 0)
 	input.OracleKeeper.SetVotingInfo(input.Ctx, ValAddrs[0], votingInfo)
 
 	queryParams := types.NewQueryVotingInfoParams(ValAddrs[0])
@@ -283,9 +285,9 @@ func TestQueryVotingInfos(t *testing.T) {
 	input := CreateTestInput(t)
 	querier := NewQuerier(input.OracleKeeper)
 
-	votingInfo1 := types.NewVotingInfo(ValAddrs[0], 7, 1, 32)
+	votingInfo1 := types.NewVotingInfo(ValAddrs[0], 7, 1, 32, 0)
 	input.OracleKeeper.SetVotingInfo(input.Ctx, ValAddrs[0], votingInfo1)
-	votingInfo2 := types.NewVotingInfo(ValAddrs[1], 7, 1, 32)
+	votingInfo2 := types.NewVotingInfo(ValAddrs[1], 7, 1, 32, 0)
 	input.OracleKeeper.SetVotingInfo(input.Ctx, ValAddrs[1], votingInfo2)
 
 	queryParams := types.NewQueryVotingInfosParams(1, 2)
@@ -317,3 +319,117 @@ func TestQueryVotingInfos(t *testing.T) {
 
 	require.Equal(t, votingInfos, resVotingInfos)
 }
+
+func TestQueryHistoricalPriceAndTWAP(t *testing.T) {
+	cdc := codec.New()
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.OracleKeeper)
+	setPriceHistoryParams(input, 10, 10)
+
+	input.OracleKeeper.RecordPriceSnapshot(input.Ctx.WithBlockHeight(10), core.MicroSDRDenom, sdk.NewDec(100))
+	input.OracleKeeper.RecordPriceSnapshot(input.Ctx.WithBlockHeight(20), core.MicroSDRDenom, sdk.NewDec(200))
+	ctx := input.Ctx.WithBlockHeight(20)
+
+	bz, err := cdc.MarshalJSON(types.NewQueryHistoricalPriceParams(core.MicroSDRDenom, 12))
+	require.NoError(t, err)
+
+	res, err := querier(ctx, []string{types.QueryHistoricalPrice}, abci.RequestQuery{Path: "", Data: bz})
+	require.NoError(t, err)
+
+	var price sdk.Dec
+	require.NoError(t, cdc.UnmarshalJSON(res, &price))
+	require.Equal(t, sdk.NewDec(100), price)
+
+	bz, err = cdc.MarshalJSON(types.NewQueryTWAPParams(core.MicroSDRDenom, 2))
+	require.NoError(t, err)
+
+	res, err = querier(ctx, []string{types.QueryTWAP}, abci.RequestQuery{Path: "", Data: bz})
+	require.NoError(t, err)
+
+	var twap sdk.Dec
+	require.NoError(t, cdc.UnmarshalJSON(res, &twap))
+	require.Equal(t, sdk.NewDec(150), twap)
+
+	// non-positive periods
+	bz, err = cdc.MarshalJSON(types.NewQueryTWAPParams(core.MicroSDRDenom, 0))
+	require.NoError(t, err)
+
+	_, err = querier(ctx, []string{types.QueryTWAP}, abci.RequestQuery{Path: "", Data: bz})
+	require.Error(t, err)
+}
+
+func TestQueryPerformance(t *testing.T) {
+	cdc := codec.New()
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.OracleKeeper)
+
+	bz, err := cdc.MarshalJSON(types.NewQueryPerformanceParams(ValAddrs[0]))
+	require.NoError(t, err)
+
+	_, err = querier(input.Ctx, []string{types.QueryPerformance}, abci.RequestQuery{Path: "", Data: bz})
+	require.Error(t, err)
+
+	input.OracleKeeper.RecordAbstention(input.Ctx, ValAddrs[0])
+
+	res, err := querier(input.Ctx, []string{types.QueryPerformance}, abci.RequestQuery{Path: "", Data: bz})
+	require.NoError(t, err)
+
+	var performance types.ValidatorPerformance
+	cdc.UnmarshalJSON(res, &performance)
+	require.Equal(t, ValAddrs[0], performance.Address)
+	require.Equal(t, int64(1), performance.Abstentions)
+}
+
+func TestQueryPerformances(t *testing.T) {
+	cdc := codec.New()
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.OracleKeeper)
+
+	for _, valAddr := range ValAddrs {
+		input.OracleKeeper.RecordAbstention(input.Ctx, valAddr)
+	}
+
+	bz, err := cdc.MarshalJSON(types.NewQueryPerformancesParams(1, 2))
+	require.NoError(t, err)
+
+	res, err := querier(input.Ctx, []string{types.QueryPerformances}, abci.RequestQuery{Path: "", Data: bz})
+	require.NoError(t, err)
+
+	var performances types.ValidatorPerformances
+	cdc.UnmarshalJSON(res, &performances)
+	require.Equal(t, 2, len(performances))
+
+	// The last page holds the rest of the validators
+	bz, err = cdc.MarshalJSON(types.NewQueryPerformancesParams(2, 2))
+	require.NoError(t, err)
+
+	res, err = querier(input.Ctx, []string{types.QueryPerformances}, abci.RequestQuery{Path: "", Data: bz})
+	require.NoError(t, err)
+
+	var lastPage types.ValidatorPerformances
+	cdc.UnmarshalJSON(res, &lastPage)
+	require.Equal(t, len(ValAddrs)-2, len(lastPage))
+	require.NotEqual(t, performances[0].Address, lastPage[0].Address)
+}
+
+func TestQueryBallotPreview(t *testing.T) {
+	cdc := codec.New()
+	input := setupBallotPreview(t)
+	querier := NewQuerier(input.OracleKeeper)
+
+	res, err := querier(input.Ctx, []string{types.QueryBallotPreview}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var previews types.BallotPreviews
+	require.NoError(t, cdc.UnmarshalJSON(res, &previews))
+
+	expected := input.OracleKeeper.PreviewBallots(input.Ctx)
+	require.Equal(t, len(expected), len(previews))
+	for i, preview := range previews {
+		require.Equal(t, expected[i].Denom, preview.Denom)
+		require.Equal(t, expected[i].Passing, preview.Passing)
+		require.Equal(t, expected[i].Price, preview.Price)
+		require.Equal(t, len(expected[i].Winners), len(preview.Winners))
+		require.Equal(t, len(expected[i].Losers), len(preview.Losers))
+	}
+}
@@ -37,6 +37,7 @@ func (k Keeper) RewardBallotWinners(ctx sdk.Context, ballotWinners types.ClaimPo
 				if rewardeeVal != nil {
 					k.distrKeeper.AllocateTokensToValidator(ctx, rewardeeVal, sdk.NewDecCoins(rewardCoins))
 					distributedReward = distributedReward.Add(rewardCoins)
+					k.recordReward(ctx, winner.Recipient, rewardCoins)
 				}
 			}
 
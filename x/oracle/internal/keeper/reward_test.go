@@ -60,4 +60,8 @@ func TestRewardBallotWinners(t *testing.T) {
 	outstandingRewards1 := input.DistrKeeper.GetValidatorOutstandingRewards(ctx, addr1)
 	require.Equal(t, sdk.NewDecFromInt(givingAmt.AmountOf(core.MicroLunaDenom)).Mul(input.OracleKeeper.RewardFraction(ctx)).QuoInt64(3).MulInt64(2),
 		outstandingRewards1.AmountOf(core.MicroLunaDenom))
+
+	// Rewards are recorded in the performance of the winners
+	performance, _ := input.OracleKeeper.GetValidatorPerformance(ctx, addr1)
+	require.Equal(t, outstandingRewards1.AmountOf(core.MicroLunaDenom).TruncateInt(), performance.Rewards.AmountOf(core.MicroLunaDenom))
 }
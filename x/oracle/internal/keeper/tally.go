@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/oracle/internal/types"
+)
+
+// Tally calculates the price of the ballot with the tally strategy and returns it, together with the set of
+// voters to be rewarded, i.e. voted within a reasonable spread from the price
+func (k Keeper) Tally(ctx sdk.Context, pb types.PriceBallot, strategy types.TallyStrategy) (price sdk.Dec, ballotWinners types.ClaimPool, ballotLosers []sdk.ValAddress) {
+	if !sort.IsSorted(pb) {
+		sort.Sort(pb)
+	}
+
+	price = strategy.Price(ctx, pb, k.StakingKeeper)
+	rewardSpread := k.rewardSpread(ctx, pb)
+
+	for _, vote := range pb {
+		if vote.Price.GTE(price.Sub(rewardSpread)) && vote.Price.LTE(price.Add(rewardSpread)) {
+			if validator := k.StakingKeeper.Validator(ctx, vote.Voter); validator != nil {
+				power := validator.GetConsensusPower()
+
+				ballotWinners = append(ballotWinners, types.Claim{
+					Recipient: vote.Voter,
+					Weight:    power,
+				})
+			} else {
+				ballotLosers = append(ballotLosers, vote.Voter)
+			}
+		}
+	}
+
+	return
+}
+
+// rewardSpread returns the spread around the price within which votes are rewarded; half the reward band,
+// or the standard deviation of the ballot if larger
+func (k Keeper) rewardSpread(ctx sdk.Context, pb types.PriceBallot) sdk.Dec {
+	standardDeviation := pb.StandardDeviation(ctx, k.StakingKeeper)
+	rewardSpread := k.RewardBand(ctx).QuoInt64(2)
+
+	if standardDeviation.GT(rewardSpread) {
+		rewardSpread = standardDeviation
+	}
+
+	return rewardSpread
+}
+
+// ballotThreshold returns the voting power a ballot needs to pass
+func (k Keeper) ballotThreshold(ctx sdk.Context) sdk.Int {
+	totalBondedPower := sdk.TokensToConsensusPower(k.StakingKeeper.TotalBondedTokens(ctx))
+	return k.VoteThreshold(ctx).MulInt64(totalBondedPower).RoundInt()
+}
+
+// BallotIsPassing returns whether the ballot for the asset is passing the threshold amount of voting power
+func (k Keeper) BallotIsPassing(ctx sdk.Context, ballot types.PriceBallot) bool {
+	ballotPower := sdk.NewInt(ballot.Power(ctx, k.StakingKeeper))
+	return ballotPower.GTE(k.ballotThreshold(ctx))
+}
+
+// crossRateBallotThreshold returns the voting power a cross rate ballot needs to pass
+func (k Keeper) crossRateBallotThreshold(ctx sdk.Context, referenceBallot types.PriceBallot) sdk.Int {
+	referencePower := referenceBallot.Power(ctx, k.StakingKeeper)
+	return k.VoteThreshold(ctx).MulInt64(referencePower).RoundInt()
+}
+
+// CrossRateBallotIsPassing returns whether the cross rate ballot for the asset is passing the threshold
+// amount of the reference ballot voting power
+func (k Keeper) CrossRateBallotIsPassing(ctx sdk.Context, ballot types.PriceBallot, referenceBallot types.PriceBallot) bool {
+	ballotPower := sdk.NewInt(ballot.Power(ctx, k.StakingKeeper))
+	return ballotPower.IsPositive() && ballotPower.GTE(k.crossRateBallotThreshold(ctx, referenceBallot))
+}
+
+// PreviewBallots runs the tally of every whitelisted denom on the votes revealed so far, as the EndBlocker
+// would at the end of the vote period, without applying the price band or touching the store
+func (k Keeper) PreviewBallots(ctx sdk.Context) (previews types.BallotPreviews) {
+	previews = types.BallotPreviews{}
+	votes := k.CollectVotes(ctx)
+
+	previewBallot := func(denom string, pb types.PriceBallot, threshold sdk.Int, passing bool) types.BallotPreview {
+		preview := types.BallotPreview{
+			Denom:        denom,
+			Power:        pb.Power(ctx, k.StakingKeeper),
+			Threshold:    threshold,
+			Passing:      passing,
+			Median:       sdk.ZeroDec(),
+			Price:        sdk.ZeroDec(),
+			RewardSpread: sdk.ZeroDec(),
+			Winners:      types.ClaimPool{},
+			Losers:       []sdk.ValAddress{},
+		}
+		if len(pb) == 0 {
+			return preview
+		}
+
+		preview.Price, preview.Winners, _ = k.Tally(ctx, pb, k.TallyStrategy(ctx, denom))
+		preview.Median = pb.WeightedMedian(ctx, k.StakingKeeper)
+		preview.RewardSpread = k.rewardSpread(ctx, pb)
+
+		winners := make(map[string]bool)
+		for _, winner := range preview.Winners {
+			winners[winner.Recipient.String()] = true
+		}
+		for _, vote := range pb {
+			if !winners[vote.Voter.String()] {
+				preview.Losers = append(preview.Losers, vote.Voter)
+			}
+		}
+
+		return preview
+	}
+
+	// Mirrors the cross-rate mode of the EndBlocker; the other denoms are tallied on their cross rates
+	// only if the reference ballot passes
+	referenceDenom := k.ReferenceDenom(ctx)
+	referenceBallot := votes[referenceDenom].NonAbstain()
+	var referencePreview types.BallotPreview
+	if len(referenceDenom) != 0 {
+		referencePreview = previewBallot(referenceDenom, referenceBallot, k.ballotThreshold(ctx), k.BallotIsPassing(ctx, referenceBallot))
+	}
+	crossRate := referencePreview.Passing && referencePreview.Price.IsPositive()
+
+	for _, denom := range k.Whitelist(ctx) {
+		ballot := votes[denom].NonAbstain()
+
+		var preview types.BallotPreview
+		switch {
+		case denom == referenceDenom:
+			preview = referencePreview
+		case crossRate:
+			crossRateBallot := ballot.ToCrossRate(referenceBallot)
+			preview = previewBallot(denom, crossRateBallot, k.crossRateBallotThreshold(ctx, referenceBallot),
+				k.CrossRateBallotIsPassing(ctx, crossRateBallot, referenceBallot))
+			preview.ReferenceDenom = referenceDenom
+		default:
+			preview = previewBallot(denom, ballot, k.ballotThreshold(ctx), k.BallotIsPassing(ctx, ballot))
+		}
+
+		previews = append(previews, preview)
+	}
+
+	return
+}
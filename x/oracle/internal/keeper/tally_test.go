@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/oracle/internal/types"
+)
+
+func setupBallotPreview(t *testing.T) TestInput {
+	input := CreateTestInput(t)
+	sh := staking.NewHandler(input.StakingKeeper)
+	amt := sdk.TokensFromConsensusPower(100)
+	for i := 0; i < 3; i++ {
+		got := sh(input.Ctx, NewTestMsgCreateValidator(ValAddrs[i], PubKeys[i], amt))
+		require.True(t, got.IsOK())
+	}
+	staking.EndBlocker(input.Ctx, input.StakingKeeper)
+
+	// Validator 2 votes far off the median on SDR; only validator 0 votes on KRW, validator 1 abstains
+	prices := []types.PriceTuples{
+		{types.NewPriceTuple(core.MicroSDRDenom, sdk.NewDec(10)), types.NewPriceTuple(core.MicroKRWDenom, sdk.NewDec(1000))},
+		{types.NewPriceTuple(core.MicroSDRDenom, sdk.NewDec(11)), types.NewPriceTuple(core.MicroKRWDenom, sdk.ZeroDec())},
+		{types.NewPriceTuple(core.MicroSDRDenom, sdk.NewDec(50))},
+	}
+	for i, tuples := range prices {
+		input.OracleKeeper.AddAggregateVote(input.Ctx, types.NewAggregatePriceVote(tuples, ValAddrs[i]))
+	}
+
+	return input
+}
+
+func TestPreviewBallots(t *testing.T) {
+	input := setupBallotPreview(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{core.MicroSDRDenom, core.MicroKRWDenom, core.MicroUSDDenom}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	previews := input.OracleKeeper.PreviewBallots(input.Ctx)
+	require.Equal(t, 3, len(previews))
+
+	sdr := previews[0]
+	require.Equal(t, core.MicroSDRDenom, sdr.Denom)
+	require.Equal(t, int64(300), sdr.Power)
+	require.Equal(t, sdk.NewInt(150), sdr.Threshold)
+	require.True(t, sdr.Passing)
+	require.Equal(t, sdk.NewDec(11), sdr.Median)
+	require.Equal(t, sdk.NewDec(11), sdr.Price)
+	require.Equal(t, types.ClaimPool{types.NewClaim(100, ValAddrs[0]), types.NewClaim(100, ValAddrs[1])}, sdr.Winners)
+	require.Equal(t, []sdk.ValAddress{ValAddrs[2]}, sdr.Losers)
+
+	// The abstain takes no part in the ballot, which misses the threshold
+	krw := previews[1]
+	require.Equal(t, int64(100), krw.Power)
+	require.False(t, krw.Passing)
+	require.Equal(t, sdk.NewDec(1000), krw.Median)
+	require.Empty(t, krw.Losers)
+
+	usd := previews[2]
+	require.Equal(t, int64(0), usd.Power)
+	require.False(t, usd.Passing)
+	require.True(t, usd.Price.IsZero())
+	require.Empty(t, usd.Winners)
+
+	// The preview leaves the votes and prices untouched
+	require.Equal(t, 2, len(input.OracleKeeper.CollectVotes(input.Ctx)[core.MicroKRWDenom]))
+	_, err := input.OracleKeeper.GetLunaPrice(input.Ctx, core.MicroSDRDenom)
+	require.Error(t, err)
+}
+
+func TestPreviewBallotsCrossRate(t *testing.T) {
+	input := setupBallotPreview(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{core.MicroSDRDenom, core.MicroKRWDenom}
+	params.ReferenceDenom = core.MicroSDRDenom
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	previews := input.OracleKeeper.PreviewBallots(input.Ctx)
+	require.Equal(t, 2, len(previews))
+	require.Empty(t, previews[0].ReferenceDenom)
+	require.True(t, previews[0].Passing)
+
+	// KRW is tallied on its cross rate to SDR, against the power of the SDR ballot
+	krw := previews[1]
+	require.Equal(t, core.MicroSDRDenom, krw.ReferenceDenom)
+	require.Equal(t, sdk.NewInt(150), krw.Threshold)
+	require.False(t, krw.Passing)
+	require.Equal(t, sdk.NewDec(100), krw.Price)
+}
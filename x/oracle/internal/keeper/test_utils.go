@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 )
@@ -54,14 +55,15 @@ var (
 
 // TestInput nolint
 type TestInput struct {
-	Ctx           sdk.Context
-	Cdc           *codec.Codec
-	AccKeeper     auth.AccountKeeper
-	BankKeeper    bank.Keeper
-	OracleKeeper  Keeper
-	SupplyKeeper  supply.Keeper
-	StakingKeeper staking.Keeper
-	DistrKeeper   distr.Keeper
+	Ctx            sdk.Context
+	Cdc            *codec.Codec
+	AccKeeper      auth.AccountKeeper
+	BankKeeper     bank.Keeper
+	OracleKeeper   Keeper
+	SupplyKeeper   supply.Keeper
+	StakingKeeper  staking.Keeper
+	SlashingKeeper slashing.Keeper
+	DistrKeeper    distr.Keeper
 }
 
 func newTestCodec() *codec.Codec {
@@ -89,6 +91,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	tKeyStaking := sdk.NewKVStoreKey(staking.TStoreKey)
 	keyDistr := sdk.NewKVStoreKey(distr.StoreKey)
 	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keySlashing := sdk.NewKVStoreKey(slashing.StoreKey)
 
 	cdc := newTestCodec()
 	db := dbm.NewMemDB()
@@ -103,6 +106,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	ms.MountStoreWithDB(tKeyStaking, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyDistr, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySlashing, sdk.StoreTypeIAVL, db)
 
 	require.NoError(t, ms.LoadLatestVersion())
 
@@ -171,14 +175,16 @@ func CreateTestInput(t *testing.T) TestInput {
 		require.NoError(t, err)
 	}
 
-	keeper := NewKeeper(cdc, keyOracle, paramsKeeper.Subspace(types.DefaultParamspace), distrKeeper, stakingKeeper, supplyKeeper, distr.ModuleName, types.DefaultCodespace)
+	slashingKeeper := slashing.NewKeeper(cdc, keySlashing, stakingKeeper, paramsKeeper.Subspace(slashing.DefaultParamspace), slashing.DefaultCodespace)
+
+	keeper := NewKeeper(cdc, keyOracle, paramsKeeper.Subspace(types.DefaultParamspace), distrKeeper, stakingKeeper, slashingKeeper, supplyKeeper, distr.ModuleName, types.DefaultCodespace)
 
 	defaults := types.DefaultParams()
 	keeper.SetParams(ctx, defaults)
 
 	stakingKeeper.SetHooks(staking.NewMultiStakingHooks(distrKeeper.Hooks(), keeper.Hooks()))
 
-	return TestInput{ctx, cdc, accountKeeper, bankKeeper, keeper, supplyKeeper, stakingKeeper, distrKeeper}
+	return TestInput{ctx, cdc, accountKeeper, bankKeeper, keeper, supplyKeeper, stakingKeeper, slashingKeeper, distrKeeper}
 }
 
 func NewTestMsgCreateValidator(address sdk.ValAddress, pubKey crypto.PubKey, amt sdk.Int) staking.MsgCreateValidator {
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// PriceTuple - struct to represent a price of Luna in a single denom asset
+type PriceTuple struct {
+	Denom string  `json:"denom"`
+	Price sdk.Dec `json:"price"`
+}
+
+// NewPriceTuple creates a PriceTuple instance
+func NewPriceTuple(denom string, price sdk.Dec) PriceTuple {
+	return PriceTuple{
+		Denom: denom,
+		Price: price,
+	}
+}
+
+// String implements fmt.Stringer
+func (pt PriceTuple) String() string {
+	return fmt.Sprintf("%s:%s", pt.Denom, pt.Price)
+}
+
+// PriceTuples is a collection of PriceTuple
+type PriceTuples []PriceTuple
+
+// String returns the tuples formatted as "denom:price,denom:price"
+func (pts PriceTuples) String() string {
+	strs := make([]string, len(pts))
+	for i, pt := range pts {
+		strs[i] = pt.String()
+	}
+	return strings.Join(strs, ",")
+}
+
+// ParsePriceTuples parses a "denom:price,denom:price" formatted string into PriceTuples.
+// Prices must not be negative, a zero price being an abstain, and each denom may appear only once.
+func ParsePriceTuples(pricesStr string) (PriceTuples, error) {
+	pricesStr = strings.TrimSpace(pricesStr)
+	if len(pricesStr) == 0 {
+		return nil, fmt.Errorf("prices string is empty")
+	}
+
+	pairs := strings.Split(pricesStr, ",")
+	tuples := make(PriceTuples, len(pairs))
+	duplicateCheck := make(map[string]bool)
+	for i, pair := range pairs {
+		parts := strings.Split(strings.TrimSpace(pair), ":")
+		if len(parts) != 2 || len(parts[0]) == 0 {
+			return nil, fmt.Errorf("invalid price tuple %q; expected denom:price", pair)
+		}
+
+		price, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid price %q for denom %s: %s", parts[1], parts[0], err)
+		}
+
+		if price.IsNegative() {
+			return nil, fmt.Errorf("price for denom %s must not be negative: %s", parts[0], price)
+		}
+
+		if duplicateCheck[parts[0]] {
+			return nil, fmt.Errorf("duplicated denom %s", parts[0])
+		}
+		duplicateCheck[parts[0]] = true
+
+		tuples[i] = NewPriceTuple(parts[0], price)
+	}
+
+	return tuples, nil
+}
+
+// AggregatePricePrevote - struct to store a validator's aggregate prevote on the prices of Luna in all denoms
+type AggregatePricePrevote struct {
+	Hash        string         `json:"hash"`  // Vote hex hash to protect centralize data source problem
+	Voter       sdk.ValAddress `json:"voter"` // Voter val address
+	SubmitBlock int64          `json:"submit_block"`
+}
+
+// NewAggregatePricePrevote creates an AggregatePricePrevote instance
+func NewAggregatePricePrevote(hash string, voter sdk.ValAddress, submitBlock int64) AggregatePricePrevote {
+	return AggregatePricePrevote{
+		Hash:        hash,
+		Voter:       voter,
+		SubmitBlock: submitBlock,
+	}
+}
+
+// String implements fmt.Stringer
+func (app AggregatePricePrevote) String() string {
+	return fmt.Sprintf(`AggregatePricePrevote
+	Hash:    %s,
+	Voter:    %s,
+	SubmitBlock:    %d`,
+		app.Hash, app.Voter, app.SubmitBlock)
+}
+
+// AggregateVoteHash computes hash value of AggregatePriceVote
+func AggregateVoteHash(salt string, pricesStr string, voter sdk.ValAddress) ([]byte, error) {
+	hash := tmhash.NewTruncated()
+	_, err := hash.Write([]byte(fmt.Sprintf("%s:%s:%s", salt, pricesStr, voter)))
+	bz := hash.Sum(nil)
+	return bz, err
+}
+
+// AggregatePriceVote - struct to store a validator's aggregate vote on the prices of Luna in all denoms
+type AggregatePriceVote struct {
+	PriceTuples PriceTuples    `json:"price_tuples"`
+	Voter       sdk.ValAddress `json:"voter"`
+}
+
+// NewAggregatePriceVote creates an AggregatePriceVote instance
+func NewAggregatePriceVote(priceTuples PriceTuples, voter sdk.ValAddress) AggregatePriceVote {
+	return AggregatePriceVote{
+		PriceTuples: priceTuples,
+		Voter:       voter,
+	}
+}
+
+// PriceVotes expands the aggregate vote into per-denom PriceVotes
+func (apv AggregatePriceVote) PriceVotes() PriceVotes {
+	votes := make(PriceVotes, len(apv.PriceTuples))
+	for i, tuple := range apv.PriceTuples {
+		votes[i] = NewPriceVote(tuple.Price, tuple.Denom, apv.Voter)
+	}
+	return votes
+}
+
+// String implements fmt.Stringer
+func (apv AggregatePriceVote) String() string {
+	return fmt.Sprintf(`AggregatePriceVote
+	PriceTuples:    %s,
+	Voter:    %s`,
+		apv.PriceTuples, apv.Voter)
+}
@@ -12,6 +12,32 @@ import (
 // PriceBallot is a convinience wrapper arounda a PriceVote slice
 type PriceBallot []PriceVote
 
+// NonAbstain returns the votes of the ballot which report a price
+func (pb PriceBallot) NonAbstain() (ballot PriceBallot) {
+	for _, vote := range pb {
+		if !vote.IsAbstain() {
+			ballot = append(ballot, vote)
+		}
+	}
+	return
+}
+
+// ToCrossRate converts the ballot into the cross rates of its denom to the denom of the
+// reference ballot; votes of voters without a vote in the reference ballot are dropped.
+func (pb PriceBallot) ToCrossRate(reference PriceBallot) (cb PriceBallot) {
+	referencePrices := make(map[string]sdk.Dec)
+	for _, vote := range reference {
+		referencePrices[vote.Voter.String()] = vote.Price
+	}
+
+	for _, vote := range pb {
+		if referencePrice, exists := referencePrices[vote.Voter.String()]; exists && referencePrice.IsPositive() {
+			cb = append(cb, NewPriceVote(vote.Price.Quo(referencePrice), vote.Denom, vote.Voter))
+		}
+	}
+	return
+}
+
 // Returns the total amount of voting power in the ballot
 func (pb PriceBallot) Power(ctx sdk.Context, sk StakingKeeper) int64 {
 	totalPower := int64(0)
@@ -43,6 +69,58 @@ func (pb PriceBallot) WeightedMedian(ctx sdk.Context, sk StakingKeeper) sdk.Dec
 	return sdk.ZeroDec()
 }
 
+// Returns the mean of the prices weighted by the power of the PriceVote, after trimming
+// trimFraction of the total power from each end of the sorted ballot.
+func (pb PriceBallot) WeightedTrimmedMean(ctx sdk.Context, sk StakingKeeper, trimFraction sdk.Dec) sdk.Dec {
+	totalPower := pb.Power(ctx, sk)
+	if totalPower == 0 {
+		return sdk.ZeroDec()
+	}
+
+	if !sort.IsSorted(pb) {
+		sort.Sort(pb)
+	}
+
+	trimmedPower := trimFraction.MulInt64(totalPower)
+	lower, upper := trimmedPower, sdk.NewDec(totalPower).Sub(trimmedPower)
+
+	// Only the part of each vote power which lies within [lower, upper] is weighted
+	sum, weight := sdk.ZeroDec(), sdk.ZeroDec()
+	pivot := sdk.ZeroDec()
+	for _, v := range pb {
+		start := pivot
+		pivot = pivot.Add(sdk.NewDec(v.getPower(ctx, sk)))
+
+		inside := sdk.MinDec(pivot, upper).Sub(sdk.MaxDec(start, lower))
+		if inside.IsPositive() {
+			sum = sum.Add(v.Price.Mul(inside))
+			weight = weight.Add(inside)
+		}
+	}
+
+	if !weight.IsPositive() {
+		return pb.WeightedMedian(ctx, sk)
+	}
+
+	return sum.Quo(weight)
+}
+
+// Returns the median weighted by the power of the PriceVote, after dropping the votes more than
+// k standard deviations away from the weighted median of the whole ballot.
+func (pb PriceBallot) FilteredWeightedMedian(ctx sdk.Context, sk StakingKeeper, k sdk.Dec) sdk.Dec {
+	median := pb.WeightedMedian(ctx, sk)
+	maxDeviation := pb.StandardDeviation(ctx, sk).Mul(k)
+
+	var filtered PriceBallot
+	for _, v := range pb {
+		if v.Price.Sub(median).Abs().LTE(maxDeviation) {
+			filtered = append(filtered, v)
+		}
+	}
+
+	return filtered.WeightedMedian(ctx, sk)
+}
+
 // Returns the standard deviation by the power of the PriceVote.
 func (pb PriceBallot) StandardDeviation(ctx sdk.Context, sk StakingKeeper) (standardDeviation sdk.Dec) {
 	if len(pb) == 0 {
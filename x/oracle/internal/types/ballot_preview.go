@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BallotPreview - outcome the tally would have on the votes of a denom revealed so far in the vote period
+type BallotPreview struct {
+	Denom          string           `json:"denom" yaml:"denom"`
+	ReferenceDenom string           `json:"reference_denom" yaml:"reference_denom"` // set when tallied on the cross rates to the reference denom
+	Power          int64            `json:"power" yaml:"power"`                     // voting power of the ballot, abstains excluded
+	Threshold      sdk.Int          `json:"threshold" yaml:"threshold"`             // voting power the ballot needs to pass
+	Passing        bool             `json:"passing" yaml:"passing"`
+	Median         sdk.Dec          `json:"median" yaml:"median"`               // weighted median of the ballot
+	Price          sdk.Dec          `json:"price" yaml:"price"`                 // price of the tally strategy of the denom, around which votes are rewarded
+	RewardSpread   sdk.Dec          `json:"reward_spread" yaml:"reward_spread"` // spread around the price within which votes are rewarded
+	Winners        ClaimPool        `json:"winners" yaml:"winners"`
+	Losers         []sdk.ValAddress `json:"losers" yaml:"losers"`
+}
+
+// String implements fmt.Stringer
+func (bp BallotPreview) String() string {
+	return fmt.Sprintf(`BallotPreview
+	Denom:           %s
+	ReferenceDenom:  %s
+	Power:           %d
+	Threshold:       %s
+	Passing:         %v
+	Median:          %s
+	Price:           %s
+	RewardSpread:    %s
+	Winners:         %s
+	Losers:          %v`,
+		bp.Denom, bp.ReferenceDenom, bp.Power, bp.Threshold, bp.Passing,
+		bp.Median, bp.Price, bp.RewardSpread, bp.Winners, bp.Losers)
+}
+
+// BallotPreviews is a collection of BallotPreview
+type BallotPreviews []BallotPreview
+
+// String implements fmt.Stringer
+func (bps BallotPreviews) String() (out string) {
+	for _, bp := range bps {
+		out += bp.String() + "\n"
+	}
+	return
+}
@@ -65,6 +65,29 @@ func TestPBPower(t *testing.T) {
 	require.Equal(t, ballotPower, pb.Power(ctx, sk))
 }
 
+func TestPBToCrossRate(t *testing.T) {
+	voters := []sdk.ValAddress{
+		sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+	}
+
+	reference := PriceBallot{
+		NewPriceVote(sdk.NewDec(2), core.MicroSDRDenom, voters[0]),
+		NewPriceVote(sdk.NewDec(4), core.MicroSDRDenom, voters[1]),
+	}
+	pb := PriceBallot{
+		NewPriceVote(sdk.NewDec(3000), core.MicroKRWDenom, voters[0]),
+		NewPriceVote(sdk.NewDec(5000), core.MicroKRWDenom, voters[1]),
+		NewPriceVote(sdk.NewDec(1000), core.MicroKRWDenom, voters[2]), // no reference vote
+	}
+
+	cb := pb.ToCrossRate(reference)
+	require.Equal(t, 2, len(cb))
+	require.Equal(t, NewPriceVote(sdk.NewDec(1500), core.MicroKRWDenom, voters[0]), cb[0])
+	require.Equal(t, NewPriceVote(sdk.NewDec(1250), core.MicroKRWDenom, voters[1]), cb[1])
+}
+
 func TestPBWeightedMedian(t *testing.T) {
 	tests := []struct {
 		inputs      []float64
@@ -126,6 +149,154 @@ func TestPBWeightedMedian(t *testing.T) {
 	}
 }
 
+func TestPBWeightedTrimmedMean(t *testing.T) {
+	tests := []struct {
+		inputs       []float64
+		weights      []int64
+		isValidator  []bool
+		trimFraction sdk.Dec
+		mean         sdk.Dec
+	}{
+		{
+			// Nothing trimmed
+			[]float64{1.0, 2.0, 10.0, 100000.0},
+			[]int64{1, 1, 100, 1},
+			[]bool{true, true, true, true},
+			sdk.ZeroDec(),
+			sdk.MustNewDecFromStr("980.611650485436893204"),
+		},
+		{
+			// Outliers trimmed
+			[]float64{1.0, 2.0, 10.0, 100000.0},
+			[]int64{1, 1, 100, 1},
+			[]bool{true, true, true, true},
+			sdk.NewDecWithPrec(1, 1),
+			sdk.NewDec(10),
+		},
+		{
+			// Adding fake validator doesn't change outcome
+			[]float64{1.0, 2.0, 10.0, 100000.0, 10000000000},
+			[]int64{1, 1, 100, 1, 10000},
+			[]bool{true, true, true, true, false},
+			sdk.NewDecWithPrec(1, 1),
+			sdk.NewDec(10),
+		},
+		{
+			// Partially trimmed votes
+			[]float64{1.0, 2.0, 3.0, 4.0},
+			[]int64{1, 1, 1, 1},
+			[]bool{true, true, true, true},
+			sdk.NewDecWithPrec(25, 2),
+			sdk.NewDecWithPrec(25, 1),
+		},
+		{
+			// No votes
+			[]float64{},
+			[]int64{},
+			[]bool{true, true, true, true},
+			sdk.NewDecWithPrec(1, 1),
+			sdk.NewDecWithPrec(0, 0),
+		},
+	}
+
+	var mockValset []MockValidator
+	base := math.Pow10(oracleDecPrecision)
+	for _, tc := range tests {
+		pb := PriceBallot{}
+		for i, input := range tc.inputs {
+			valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+			power := tc.weights[i]
+			mockVal := NewMockValidator(valAddr, power)
+
+			if tc.isValidator[i] {
+				mockValset = append(mockValset, mockVal)
+			}
+			vote := NewPriceVote(sdk.NewDecWithPrec(int64(input*base), int64(oracleDecPrecision)), core.MicroSDRDenom, valAddr)
+			pb = append(pb, vote)
+		}
+
+		sk := NewDummyStakingKeeper(mockValset)
+
+		ctx := sdk.NewContext(nil, abci.Header{}, false, nil)
+		require.Equal(t, tc.mean, pb.WeightedTrimmedMean(ctx, sk, tc.trimFraction))
+	}
+}
+
+func TestPBFilteredWeightedMedian(t *testing.T) {
+	tests := []struct {
+		inputs      []float64
+		weights     []int64
+		isValidator []bool
+		k           sdk.Dec
+		median      sdk.Dec
+	}{
+		{
+			// Nothing filtered
+			[]float64{1.0, 2.0, 10.0, 100000.0},
+			[]int64{1, 1, 100, 1},
+			[]bool{true, true, true, true},
+			sdk.NewDec(10),
+			sdk.NewDec(10),
+		},
+		{
+			// Outlier filtered
+			[]float64{1.0, 2.0, 3.0, 4.0, 1000.0},
+			[]int64{1, 1, 1, 1, 1},
+			[]bool{true, true, true, true, true},
+			sdk.OneDec(),
+			sdk.NewDec(2),
+		},
+		{
+			// Adding fake validator doesn't change outcome
+			[]float64{1.0, 2.0, 3.0, 4.0, 1000.0, 10000000000},
+			[]int64{1, 1, 1, 1, 1, 10000},
+			[]bool{true, true, true, true, true, false},
+			sdk.OneDec(),
+			sdk.NewDec(2),
+		},
+		{
+			// Unanimous votes
+			[]float64{5.0, 5.0, 5.0},
+			[]int64{1, 2, 3},
+			[]bool{true, true, true},
+			sdk.OneDec(),
+			sdk.NewDec(5),
+		},
+		{
+			// No votes
+			[]float64{},
+			[]int64{},
+			[]bool{true, true, true, true},
+			sdk.OneDec(),
+			sdk.NewDecWithPrec(0, 0),
+		},
+	}
+
+	var mockValset []MockValidator
+	base := math.Pow10(oracleDecPrecision)
+	for _, tc := range tests {
+		pb := PriceBallot{}
+		for i, input := range tc.inputs {
+			valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+			power := tc.weights[i]
+			mockVal := NewMockValidator(valAddr, power)
+
+			if tc.isValidator[i] {
+				mockValset = append(mockValset, mockVal)
+			}
+			vote := NewPriceVote(sdk.NewDecWithPrec(int64(input*base), int64(oracleDecPrecision)), core.MicroSDRDenom, valAddr)
+			pb = append(pb, vote)
+		}
+
+		sk := NewDummyStakingKeeper(mockValset)
+
+		ctx := sdk.NewContext(nil, abci.Header{}, false, nil)
+		require.Equal(t, tc.median, pb.FilteredWeightedMedian(ctx, sk, tc.k))
+	}
+}
+
 func TestPBStandardDeviation(t *testing.T) {
 	tests := []struct {
 		inputs            []float64
@@ -12,6 +12,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgPriceVote{}, "oracle/MsgPriceVote", nil)
 	cdc.RegisterConcrete(MsgPricePrevote{}, "oracle/MsgPricePrevote", nil)
 	cdc.RegisterConcrete(MsgDelegateFeederPermission{}, "oracle/MsgDelegateFeederPermission", nil)
+	cdc.RegisterConcrete(MsgAggregatePricePrevote{}, "oracle/MsgAggregatePricePrevote", nil)
+	cdc.RegisterConcrete(MsgAggregatePriceVote{}, "oracle/MsgAggregatePriceVote", nil)
+	cdc.RegisterConcrete(MsgUnjail{}, "oracle/MsgUnjail", nil)
 }
 
 func init() {
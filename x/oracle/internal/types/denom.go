@@ -11,3 +11,13 @@ func (dl DenomList) String() (out string) {
 	out = strings.Join(dl, "\n")
 	return
 }
+
+// Contains returns true if the denom is in the list
+func (dl DenomList) Contains(denom string) bool {
+	for _, d := range dl {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
@@ -25,6 +26,17 @@ const (
 	CodeInvalidSaltLength  codeType = 10
 	CodeInvalidMsgFormat   codeType = 11
 	CodeMissingVotingInfo  codeType = 12
+	CodeNoAggregatePrevote codeType = 13
+	CodeNoAggregateVote    codeType = 14
+	CodeNoPriceHistory     codeType = 15
+	CodeValidatorNotJailed codeType = 16
+	CodeValidatorJailed    codeType = 17
+
+	CodeMissingSelfDelegation        codeType = 18
+	CodeSelfDelegationTooLowToUnjail codeType = 19
+	CodeStalePrice                   codeType = 20
+	CodeNoPerformance                codeType = 21
+	CodeValidatorTombstoned          codeType = 22
 )
 
 // ----------------------------------------
@@ -89,3 +101,53 @@ func ErrInvalidMsgFormat(codespace sdk.CodespaceType, msg string) sdk.Error {
 func ErrNoVotingInfoFound(codespace sdk.CodespaceType, valAddr sdk.ValAddress) sdk.Error {
 	return sdk.NewError(codespace, CodeMissingVotingInfo, fmt.Sprintf("no signing info found for address: %s", valAddr))
 }
+
+// ErrNoAggregatePrevote called when no aggregate prevote exists
+func ErrNoAggregatePrevote(codespace sdk.CodespaceType, voter sdk.ValAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAggregatePrevote, fmt.Sprintf("No aggregate prevote exists from %s", voter))
+}
+
+// ErrNoAggregateVote called when no aggregate vote exists
+func ErrNoAggregateVote(codespace sdk.CodespaceType, voter sdk.ValAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAggregateVote, fmt.Sprintf("No aggregate vote exists from %s", voter))
+}
+
+// ErrNoPriceHistory called when no price snapshot is stored for the denom at the height
+func ErrNoPriceHistory(codespace sdk.CodespaceType, denom string, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeNoPriceHistory, fmt.Sprintf("No price history of %s at height %d", denom, height))
+}
+
+// ErrValidatorNotJailed called when unjailing a validator which is not jailed by the oracle
+func ErrValidatorNotJailed(codespace sdk.CodespaceType, valAddr sdk.ValAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorNotJailed, fmt.Sprintf("validator %s is not jailed by the oracle, cannot be unjailed", valAddr))
+}
+
+// ErrValidatorJailed called when unjailing a validator before its oracle jail duration has elapsed
+func ErrValidatorJailed(codespace sdk.CodespaceType, valAddr sdk.ValAddress, jailedUntil time.Time) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorJailed, fmt.Sprintf("validator %s is still jailed by the oracle until %s", valAddr, jailedUntil))
+}
+
+// ErrMissingSelfDelegation called when the validator has no self delegation
+func ErrMissingSelfDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeMissingSelfDelegation, "validator has no self-delegation; cannot be unjailed")
+}
+
+// ErrSelfDelegationTooLowToUnjail called when the self delegation of the validator is below its minimum
+func ErrSelfDelegationTooLowToUnjail(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSelfDelegationTooLowToUnjail, "validator's self delegation less than MinSelfDelegation, cannot be unjailed")
+}
+
+// ErrStalePrice called when the price of the denom was tallied longer ago than the caller accepts
+func ErrStalePrice(codespace sdk.CodespaceType, denom string, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeStalePrice, fmt.Sprintf("The price of %s is stale; last tallied at height %d", denom, height))
+}
+
+// ErrNoPerformanceFound called when no oracle performance found
+func ErrNoPerformanceFound(codespace sdk.CodespaceType, valAddr sdk.ValAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNoPerformance, fmt.Sprintf("no oracle performance found for address: %s", valAddr))
+}
+
+// ErrValidatorTombstoned called when unjailing a validator tombstoned by the slashing module
+func ErrValidatorTombstoned(codespace sdk.CodespaceType, valAddr sdk.ValAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorTombstoned, fmt.Sprintf("validator %s is tombstoned, cannot be unjailed", valAddr))
+}
@@ -10,15 +10,26 @@ const (
 	EventTypeVote        = "vote"
 	EventTypeFeedDeleate = "feed_delegate"
 
-	AttributeKeyAddress     = "address"
-	AttributeKeyHeight      = "height"
-	AttributeKeyMissedVotes = "missed_votes"
-	AttributeKeyDenom       = "denom"
-	AttributeKeyVoter       = "voter"
-	AttributeKeyPower       = "power"
-	AttributeKeyPrice       = "price"
-	AttributeKeyOperator    = "operator"
-	AttributeKeyFeeder      = "feeder"
+	EventTypeAggregatePrevote = "aggregate_prevote"
+	EventTypeAggregateVote    = "aggregate_vote"
+	EventTypeUnjail           = "unjail"
+	EventTypePriceBand        = "price_band"
+
+	AttributeKeyAddress       = "address"
+	AttributeKeyHeight        = "height"
+	AttributeKeyMissedVotes   = "missed_votes"
+	AttributeKeyDenom         = "denom"
+	AttributeKeyVoter         = "voter"
+	AttributeKeyPower         = "power"
+	AttributeKeyPrice         = "price"
+	AttributeKeyOperator      = "operator"
+	AttributeKeyFeeder        = "feeder"
+	AttributeKeyPrices        = "prices"
+	AttributeKeyJailed        = "jailed"
+	AttributeKeyJailedUntil   = "jailed_until"
+	AttributeKeyPreviousPrice = "previous_price"
+	AttributeKeyTallyPrice    = "tally_price"
+	AttributeKeyMode          = "mode"
 
 	AttributeValueCategory = ModuleName
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
 	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
 	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 )
@@ -11,8 +12,16 @@ type StakingKeeper interface {
 	Validator(ctx sdk.Context, address sdk.ValAddress) stakingexported.ValidatorI // get validator by operator address; nil when validator not found
 	TotalBondedTokens(sdk.Context) sdk.Int                                        // total bonded tokens within the validator set
 	IterateBondedValidatorsByPower(sdk.Context, func(index int64, validator stakingexported.ValidatorI) (stop bool))
-	Slash(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec) // slash the validator and delegators of the validator, specifying offence height, offence power, and slash fraction
-	MaxValidators(sdk.Context) uint16                          // MaxValidators returns the maximum amount of bonded validators
+	Slash(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec)                          // slash the validator and delegators of the validator, specifying offence height, offence power, and slash fraction
+	MaxValidators(sdk.Context) uint16                                                   // MaxValidators returns the maximum amount of bonded validators
+	Jail(sdk.Context, sdk.ConsAddress)                                                  // jail a validator
+	Unjail(sdk.Context, sdk.ConsAddress)                                                // unjail a validator
+	Delegation(sdk.Context, sdk.AccAddress, sdk.ValAddress) stakingexported.DelegationI // get a particular delegation; nil when not found
+}
+
+// expected keeper for slashing module
+type SlashingKeeper interface {
+	IterateValidatorSigningInfos(ctx sdk.Context, handler func(address sdk.ConsAddress, info slashing.ValidatorSigningInfo) (stop bool))
 }
 
 // expected keeper for distribution module
@@ -6,20 +6,23 @@ import (
 
 // GenesisState - all oracle state that must be provided at genesis
 type GenesisState struct {
-	Params      Params                  `json:"params" yaml:"params"`
-	VotingInfos map[string]VotingInfo   `json:"voting_infos" yaml:"voting_infos"`
-	MissedVotes map[string][]MissedVote `json:"missed_votes" yaml:"missed_votes"`
+	Params       Params                  `json:"params" yaml:"params"`
+	VotingInfos  map[string]VotingInfo   `json:"voting_infos" yaml:"voting_infos"`
+	MissedVotes  map[string][]MissedVote `json:"missed_votes" yaml:"missed_votes"`
+	Performances ValidatorPerformances   `json:"performances" yaml:"performances"`
 }
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
 	params Params, votingInfo map[string]VotingInfo, MissedVotes map[string][]MissedVote,
+	performances ValidatorPerformances,
 ) GenesisState {
 
 	return GenesisState{
-		Params:      params,
-		VotingInfos: votingInfo,
-		MissedVotes: MissedVotes,
+		Params:       params,
+		VotingInfos:  votingInfo,
+		MissedVotes:  MissedVotes,
+		Performances: performances,
 	}
 }
 
@@ -40,9 +43,10 @@ func NewMissedVote(index int64, missed bool) MissedVote {
 // DefaultGenesisState - default GenesisState used by columbus-2
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params:      DefaultParams(),
-		VotingInfos: make(map[string]VotingInfo),
-		MissedVotes: make(map[string][]MissedVote),
+		Params:       DefaultParams(),
+		VotingInfos:  make(map[string]VotingInfo),
+		MissedVotes:  make(map[string][]MissedVote),
+		Performances: ValidatorPerformances{},
 	}
 }
 
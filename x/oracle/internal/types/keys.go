@@ -27,13 +27,27 @@ const (
 //
 // - 0x02<denom_Bytes><valAddress_Bytes>: Vote
 //
-// - 0x03<denom_Bytes>: sdk.Dec
+// - 0x03<denom_Bytes>: PriceInfo
 //
 // - 0x04<valAddress_Bytes>: accAddress
 //
 // - 0x05<valAddress_Bytes>: Claim
 //
 // - 0x06<valAddress_Bytes><period_Bytes>: bool
+//
+// - 0x07<valAddress_Bytes>: VotingInfo
+//
+// - 0x08<valAddress_Bytes>: AggregatePrevote
+//
+// - 0x09<valAddress_Bytes>: AggregateVote
+//
+// - 0x0A<denomLen_Byte><denom_Bytes><slot_Bytes>: PriceSnapshot
+//
+// - 0x0B<denom_Bytes>: int64
+//
+// - 0x0C<denom_Bytes>: int64
+//
+// - 0x0D<valAddress_Bytes>: ValidatorPerformance
 var (
 	// Keys for store prefixes
 	PrevoteKey            = []byte{0x01} // prefix for each key to a prevote
@@ -42,6 +56,12 @@ var (
 	FeederDelegationKey   = []byte{0x04} // prefix for each key to a feeder delegation
 	MissedVoteBitArrayKey = []byte{0x06} // Prefix for missed vote bit array
 	VotingInfoKey         = []byte{0x07} // Prefix for voting info
+	AggregatePrevoteKey   = []byte{0x08} // prefix for each key to an aggregate prevote
+	AggregateVoteKey      = []byte{0x09} // prefix for each key to an aggregate vote
+	PriceHistoryKey       = []byte{0x0A} // prefix for each key to a price snapshot
+	PriceHistoryIndexKey  = []byte{0x0B} // prefix for each key to a price history write counter
+	UnsettledPeriodsKey   = []byte{0x0C} // prefix for each key to a count of consecutive tallies outside the price band
+	PerformanceKey        = []byte{0x0D} // prefix for each key to a validator performance
 )
 
 // GetPrevoteKey - stored by *Validator* address and denom
@@ -80,3 +100,40 @@ func GetMissedVoteBitArrayKey(v sdk.ValAddress, i int64) []byte {
 func GetVotingInfoKey(v sdk.ValAddress) []byte {
 	return append(VotingInfoKey, v.Bytes()...)
 }
+
+// GetAggregatePrevoteKey - stored by *Validator* address
+func GetAggregatePrevoteKey(v sdk.ValAddress) []byte {
+	return append(AggregatePrevoteKey, v.Bytes()...)
+}
+
+// GetAggregateVoteKey - stored by *Validator* address
+func GetAggregateVoteKey(v sdk.ValAddress) []byte {
+	return append(AggregateVoteKey, v.Bytes()...)
+}
+
+// GetPriceHistoryPrefixKey - stored by *denom*
+func GetPriceHistoryPrefixKey(denom string) []byte {
+	return append(append(PriceHistoryKey, byte(len(denom))), []byte(denom)...)
+}
+
+// GetPriceHistoryKey - stored by *denom* and ring buffer slot
+func GetPriceHistoryKey(denom string, slot int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(slot))
+	return append(GetPriceHistoryPrefixKey(denom), b...)
+}
+
+// GetPriceHistoryIndexKey - stored by *denom*
+func GetPriceHistoryIndexKey(denom string) []byte {
+	return append(PriceHistoryIndexKey, []byte(denom)...)
+}
+
+// GetUnsettledPeriodsKey - stored by *denom*
+func GetUnsettledPeriodsKey(denom string) []byte {
+	return append(UnsettledPeriodsKey, []byte(denom)...)
+}
+
+// GetPerformanceKey - stored by *Validator* address
+func GetPerformanceKey(v sdk.ValAddress) []byte {
+	return append(PerformanceKey, v.Bytes()...)
+}
@@ -13,6 +13,9 @@ var (
 	_ sdk.Msg = &MsgDelegateFeederPermission{}
 	_ sdk.Msg = &MsgPricePrevote{}
 	_ sdk.Msg = &MsgPriceVote{}
+	_ sdk.Msg = &MsgAggregatePricePrevote{}
+	_ sdk.Msg = &MsgAggregatePriceVote{}
+	_ sdk.Msg = &MsgUnjail{}
 )
 
 //-------------------------------------------------
@@ -89,6 +92,7 @@ func (msg MsgPricePrevote) String() string {
 // MsgPriceVote - struct for voting on the price of Luna denominated in various Terra assets.
 // For example, if the validator believes that the effective price of Luna in USD is 10.39, that's
 // what the price field would be, and if 1213.34 for KRW, same.
+// A zero price is an abstain vote, reported when the feeder has no price for the denom.
 type MsgPriceVote struct {
 	Price     sdk.Dec        `json:"price" yaml:"price"` // the effective price of Luna in {Denom}
 	Salt      string         `json:"salt" yaml:"salt"`
@@ -139,7 +143,7 @@ func (msg MsgPriceVote) ValidateBasic() sdk.Error {
 		return sdk.ErrInvalidAddress("Invalid address: " + msg.Feeder.String())
 	}
 
-	if msg.Price.LTE(sdk.ZeroDec()) {
+	if msg.Price.IsNegative() {
 		return ErrInvalidPrice(DefaultCodespace, msg.Price)
 	}
 
@@ -211,3 +215,175 @@ func (msg MsgDelegateFeederPermission) String() string {
 	delegatee:   %s`,
 		msg.Operator, msg.Delegatee)
 }
+
+// MsgAggregatePricePrevote - struct for aggregate prevoting on the PriceVotes of all denoms.
+// The purpose of aggregate prevote is to hide vote prices with hash
+// which is formatted as hex string in SHA256("salt:denom:price,denom:price,...:voter")
+type MsgAggregatePricePrevote struct {
+	Hash      string         `json:"hash" yaml:"hash"` // hex string
+	Feeder    sdk.AccAddress `json:"feeder" yaml:"feeder"`
+	Validator sdk.ValAddress `json:"validator" yaml:"validator"`
+}
+
+// NewMsgAggregatePricePrevote creates a MsgAggregatePricePrevote instance
+func NewMsgAggregatePricePrevote(voteHash string, feederAddress sdk.AccAddress, valAddress sdk.ValAddress) MsgAggregatePricePrevote {
+	return MsgAggregatePricePrevote{
+		Hash:      voteHash,
+		Feeder:    feederAddress,
+		Validator: valAddress,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgAggregatePricePrevote) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgAggregatePricePrevote) Type() string { return "aggregatepriceprevote" }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgAggregatePricePrevote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAggregatePricePrevote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Feeder}
+}
+
+// ValidateBasic Implements sdk.Msg
+func (msg MsgAggregatePricePrevote) ValidateBasic() sdk.Error {
+
+	if bz, err := hex.DecodeString(msg.Hash); len(bz) != tmhash.TruncatedSize || err != nil {
+		return ErrInvalidHashLength(DefaultCodespace, len([]byte(msg.Hash)))
+	}
+
+	if msg.Feeder.Empty() {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Feeder.String())
+	}
+
+	if msg.Validator.Empty() {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Validator.String())
+	}
+
+	return nil
+}
+
+// String Implements Msg
+func (msg MsgAggregatePricePrevote) String() string {
+	return fmt.Sprintf(`MsgAggregatePricePrevote
+	hash:     %s,
+	feeder:    %s, 
+	validator:    %s`,
+		msg.Hash, msg.Feeder, msg.Validator)
+}
+
+// MsgAggregatePriceVote - struct for voting on the prices of Luna denominated in all Terra assets
+// at once. Prices are given as "denom:price,denom:price" and must match the companion aggregate prevote.
+type MsgAggregatePriceVote struct {
+	Salt      string         `json:"salt" yaml:"salt"`
+	Prices    string         `json:"prices" yaml:"prices"` // comma separated denom:price pairs
+	Feeder    sdk.AccAddress `json:"feeder" yaml:"feeder"`
+	Validator sdk.ValAddress `json:"validator" yaml:"validator"`
+}
+
+// NewMsgAggregatePriceVote creates a MsgAggregatePriceVote instance
+func NewMsgAggregatePriceVote(salt string, prices string, feederAddress sdk.AccAddress, valAddress sdk.ValAddress) MsgAggregatePriceVote {
+	return MsgAggregatePriceVote{
+		Salt:      salt,
+		Prices:    prices,
+		Feeder:    feederAddress,
+		Validator: valAddress,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgAggregatePriceVote) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgAggregatePriceVote) Type() string { return "aggregatepricevote" }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgAggregatePriceVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAggregatePriceVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Feeder}
+}
+
+// ValidateBasic Implements sdk.Msg
+func (msg MsgAggregatePriceVote) ValidateBasic() sdk.Error {
+
+	if msg.Feeder.Empty() {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Feeder.String())
+	}
+
+	if msg.Validator.Empty() {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.Validator.String())
+	}
+
+	if _, err := ParsePriceTuples(msg.Prices); err != nil {
+		return ErrInvalidMsgFormat(DefaultCodespace, err.Error())
+	}
+
+	if len(msg.Salt) > 4 || len(msg.Salt) < 1 {
+		return ErrInvalidSaltLength(DefaultCodespace, len(msg.Salt))
+	}
+
+	return nil
+}
+
+// String Implements Msg
+func (msg MsgAggregatePriceVote) String() string {
+	return fmt.Sprintf(`MsgAggregatePriceVote
+	prices:     %s,
+	salt:     %s,
+	feeder:    %s, 
+	validator:    %s`,
+		msg.Prices, msg.Salt, msg.Feeder, msg.Validator)
+}
+
+// MsgUnjail - struct for unjailing a validator jailed by the oracle
+type MsgUnjail struct {
+	ValidatorAddr sdk.ValAddress `json:"address" yaml:"address"` // address of the validator operator
+}
+
+// NewMsgUnjail creates a MsgUnjail instance
+func NewMsgUnjail(validatorAddr sdk.ValAddress) MsgUnjail {
+	return MsgUnjail{
+		ValidatorAddr: validatorAddr,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgUnjail) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgUnjail) Type() string { return "unjail" }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUnjail) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUnjail) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.ValidatorAddr)}
+}
+
+// ValidateBasic Implements sdk.Msg
+func (msg MsgUnjail) ValidateBasic() sdk.Error {
+	if msg.ValidatorAddr.Empty() {
+		return sdk.ErrInvalidAddress("Invalid address: " + msg.ValidatorAddr.String())
+	}
+
+	return nil
+}
+
+// String Implements Msg
+func (msg MsgUnjail) String() string {
+	return fmt.Sprintf(`MsgUnjail
+	address:    %s`,
+		msg.ValidatorAddr)
+}
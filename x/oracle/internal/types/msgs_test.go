@@ -52,7 +52,8 @@ func TestMsgPriceVote(t *testing.T) {
 	}{
 		{"", addrs[0], "123", sdk.OneDec(), false},
 		{core.MicroCNYDenom, addrs[0], "123", sdk.OneDec().MulInt64(core.MicroUnit), true},
-		{core.MicroCNYDenom, addrs[0], "123", sdk.ZeroDec(), false},
+		{core.MicroCNYDenom, addrs[0], "123", sdk.ZeroDec(), true},
+		{core.MicroCNYDenom, addrs[0], "123", sdk.NewDec(-1), false},
 		{core.MicroCNYDenom, sdk.AccAddress{}, "123", sdk.OneDec().MulInt64(core.MicroUnit), false},
 		{core.MicroCNYDenom, addrs[0], "", sdk.OneDec().MulInt64(core.MicroUnit), false},
 	}
@@ -66,3 +67,84 @@ func TestMsgPriceVote(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgAggregatePricePrevote(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	bz, err := AggregateVoteHash("1", "ukrw:1000.0,uusd:0.8", sdk.ValAddress(addrs[0]))
+	require.Nil(t, err)
+
+	tests := []struct {
+		hash       string
+		voter      sdk.AccAddress
+		expectPass bool
+	}{
+		{hex.EncodeToString(bz), addrs[0], true},
+		{hex.EncodeToString(bz[:4]), addrs[0], false},
+		{hex.EncodeToString(bz), sdk.AccAddress{}, false},
+		{"", addrs[0], false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgAggregatePricePrevote(tc.hash, tc.voter, sdk.ValAddress(tc.voter))
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgAggregatePriceVote(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	tests := []struct {
+		voter      sdk.AccAddress
+		salt       string
+		prices     string
+		expectPass bool
+	}{
+		{addrs[0], "123", "ukrw:1000.0,uusd:0.8", true},
+		{addrs[0], "123", "ukrw:1000.0", true},
+		{addrs[0], "123", "", false},
+		{addrs[0], "123", "ukrw:1000.0,ukrw:999.0", false},
+		{addrs[0], "123", "ukrw:0", true},
+		{addrs[0], "123", "ukrw:-1.0", false},
+		{addrs[0], "123", "ukrw1000.0", false},
+		{addrs[0], "123", ":1000.0", false},
+		{addrs[0], "123", "ukrw:abc", false},
+		{sdk.AccAddress{}, "123", "ukrw:1000.0", false},
+		{addrs[0], "", "ukrw:1000.0", false},
+		{addrs[0], "12345", "ukrw:1000.0", false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgAggregatePriceVote(tc.salt, tc.prices, tc.voter, sdk.ValAddress(tc.voter))
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgUnjail(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	tests := []struct {
+		validator  sdk.ValAddress
+		expectPass bool
+	}{
+		{sdk.ValAddress(addrs[0]), true},
+		{sdk.ValAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgUnjail(tc.validator)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	core "github.com/terra-project/core/types"
 
@@ -21,12 +23,37 @@ var (
 	ParamStoreKeyVotesWindow            = []byte("voteswindow")
 	ParamStoreKeyMinValidVotesPerWindow = []byte("minvalidvotesperwindow")
 	ParamStoreKeySlashFraction          = []byte("slashfraction")
+	ParamStoreKeyWhitelist              = []byte("whitelist")
+	ParamStoreKeyPriceHistoryRetention  = []byte("pricehistoryretention")
+	ParamStoreKeyAbstainBudgetPerWindow = []byte("abstainbudgetperwindow")
+	ParamStoreKeyReferenceDenom         = []byte("referencedenom")
+	ParamStoreKeyJailDuration           = []byte("jailduration")
+	ParamStoreKeyTallyStrategies        = []byte("tallystrategies")
+	ParamStoreKeyMaxPriceChange         = []byte("maxpricechange")
+	ParamStoreKeyPriceBandMode          = []byte("pricebandmode")
+	ParamStoreKeyMaxUnsettledPeriods    = []byte("maxunsettledperiods")
 )
 
 // Default parameter values
 const (
 	DefaultVotePeriod  = core.BlocksPerMinute // 1 minute
 	DefaultVotesWindow = int64(1000)          // 1000 oracle period
+
+	DefaultPriceHistoryRetention = int64(1440) // 1440 oracle period
+
+	DefaultReferenceDenom = "" // cross-rate tally disabled
+
+	DefaultJailDuration = 60 * 10 * time.Second // 10 minutes
+
+	DefaultPriceBandMode = PriceBandModeClamp
+
+	DefaultMaxUnsettledPeriods = int64(10) // 10 oracle period
+)
+
+// Price band modes
+const (
+	PriceBandModeClamp  = "clamp"  // a price outside the band is clamped to its bound
+	PriceBandModeReject = "reject" // a price outside the band is rejected, and the last price kept
 )
 
 // Default parameter values
@@ -36,19 +63,37 @@ var (
 	DefaultRewardFraction         = sdk.NewDecWithPrec(1, 2)  // 1%
 	DefaultMinValidVotesPerWindow = sdk.NewDecWithPrec(5, 2)  // 5%
 	DefaultSlashFraction          = sdk.NewDecWithPrec(1, 4)  // 0.01%
+	DefaultAbstainBudgetPerWindow = sdk.NewDecWithPrec(5, 2)  // 5%
+	DefaultTallyStrategies        = DenomTallyStrategies{}    // weighted median for every denom
+	DefaultMaxPriceChange         = sdk.ZeroDec()             // price band disabled
 )
 
+// DefaultWhitelist holds every Terra denom, as the oracle tallied all the voted denoms before the whitelist
+var DefaultWhitelist = DenomList{
+	core.MicroKRWDenom, core.MicroSDRDenom, core.MicroUSDDenom, core.MicroCNYDenom,
+	core.MicroJPYDenom, core.MicroEURDenom, core.MicroGBPDenom,
+}
+
 var _ subspace.ParamSet = &Params{}
 
 // Params oracle parameters
 type Params struct {
-	VotePeriod             int64   `json:"vote_period" yaml:"vote_period"`
-	VoteThreshold          sdk.Dec `json:"vote_threshold" yaml:"vote_threshold"`
-	RewardBand             sdk.Dec `json:"reward_band" yaml:"reward_band"`
-	VotesWindow            int64   `json:"votes_window" yaml:"votes_window"`
-	MinValidVotesPerWindow sdk.Dec `json:"min_valid_votes_per_window" yaml:"min_valid_votes_per_window"`
-	SlashFraction          sdk.Dec `json:"slash_fraction" yaml:"slash_fraction"`
-	RewardFraction         sdk.Dec `json:"reward_fraction" yaml:"reward_fraction"`
+	VotePeriod             int64                `json:"vote_period" yaml:"vote_period"`
+	VoteThreshold          sdk.Dec              `json:"vote_threshold" yaml:"vote_threshold"`
+	RewardBand             sdk.Dec              `json:"reward_band" yaml:"reward_band"`
+	VotesWindow            int64                `json:"votes_window" yaml:"votes_window"`
+	MinValidVotesPerWindow sdk.Dec              `json:"min_valid_votes_per_window" yaml:"min_valid_votes_per_window"`
+	SlashFraction          sdk.Dec              `json:"slash_fraction" yaml:"slash_fraction"`
+	RewardFraction         sdk.Dec              `json:"reward_fraction" yaml:"reward_fraction"`
+	Whitelist              DenomList            `json:"whitelist" yaml:"whitelist"`
+	PriceHistoryRetention  int64                `json:"price_history_retention" yaml:"price_history_retention"`
+	AbstainBudgetPerWindow sdk.Dec              `json:"abstain_budget_per_window" yaml:"abstain_budget_per_window"`
+	ReferenceDenom         string               `json:"reference_denom" yaml:"reference_denom"`
+	JailDuration           time.Duration        `json:"jail_duration" yaml:"jail_duration"`
+	TallyStrategies        DenomTallyStrategies `json:"tally_strategies" yaml:"tally_strategies"`
+	MaxPriceChange         sdk.Dec              `json:"max_price_change" yaml:"max_price_change"`
+	PriceBandMode          string               `json:"price_band_mode" yaml:"price_band_mode"`
+	MaxUnsettledPeriods    int64                `json:"max_unsettled_periods" yaml:"max_unsettled_periods"`
 }
 
 // DefaultParams creates default oracle module parameters
@@ -61,6 +106,15 @@ func DefaultParams() Params {
 		VotesWindow:            DefaultVotesWindow,
 		MinValidVotesPerWindow: DefaultMinValidVotesPerWindow,
 		SlashFraction:          DefaultSlashFraction,
+		Whitelist:              DefaultWhitelist,
+		PriceHistoryRetention:  DefaultPriceHistoryRetention,
+		AbstainBudgetPerWindow: DefaultAbstainBudgetPerWindow,
+		ReferenceDenom:         DefaultReferenceDenom,
+		JailDuration:           DefaultJailDuration,
+		TallyStrategies:        DefaultTallyStrategies,
+		MaxPriceChange:         DefaultMaxPriceChange,
+		PriceBandMode:          DefaultPriceBandMode,
+		MaxUnsettledPeriods:    DefaultMaxUnsettledPeriods,
 	}
 }
 
@@ -87,6 +141,55 @@ func (params Params) Validate() error {
 	if params.MinValidVotesPerWindow.IsNegative() || params.MinValidVotesPerWindow.GT(sdk.OneDec()) {
 		return fmt.Errorf("Min valid votes per window should be less than or equal to one and greater than zero, is %s", params.MinValidVotesPerWindow.String())
 	}
+
+	if params.AbstainBudgetPerWindow.IsNegative() || params.AbstainBudgetPerWindow.GT(sdk.OneDec()) {
+		return fmt.Errorf("oracle parameter AbstainBudgetPerWindow should be less than or equal to one and not negative, is %s", params.AbstainBudgetPerWindow)
+	}
+	if params.JailDuration <= 0 {
+		return fmt.Errorf("oracle parameter JailDuration must be positive, is %s", params.JailDuration)
+	}
+	if params.PriceHistoryRetention <= 0 {
+		return fmt.Errorf("oracle parameter PriceHistoryRetention must be > 0, is %d", params.PriceHistoryRetention)
+	}
+	if params.MaxPriceChange.IsNegative() || params.MaxPriceChange.GTE(sdk.OneDec()) {
+		return fmt.Errorf("oracle parameter MaxPriceChange should be less than one and not negative, is %s", params.MaxPriceChange)
+	}
+	if params.PriceBandMode != PriceBandModeClamp && params.PriceBandMode != PriceBandModeReject {
+		return fmt.Errorf("oracle parameter PriceBandMode must be %s or %s, is %q", PriceBandModeClamp, PriceBandModeReject, params.PriceBandMode)
+	}
+	if params.MaxUnsettledPeriods <= 0 {
+		return fmt.Errorf("oracle parameter MaxUnsettledPeriods must be > 0, is %d", params.MaxUnsettledPeriods)
+	}
+
+	duplicateCheck := make(map[string]bool)
+	for _, denom := range params.Whitelist {
+		if len(denom) == 0 || denom == core.MicroLunaDenom {
+			return fmt.Errorf("oracle parameter Whitelist contains invalid denom %q", denom)
+		}
+		if duplicateCheck[denom] {
+			return fmt.Errorf("oracle parameter Whitelist contains duplicated denom %s", denom)
+		}
+		duplicateCheck[denom] = true
+	}
+
+	if len(params.ReferenceDenom) != 0 && !params.Whitelist.Contains(params.ReferenceDenom) {
+		return fmt.Errorf("oracle parameter ReferenceDenom must be a whitelisted denom, is %s", params.ReferenceDenom)
+	}
+
+	strategyCheck := make(map[string]bool)
+	for _, strategy := range params.TallyStrategies {
+		if !params.Whitelist.Contains(strategy.Denom) {
+			return fmt.Errorf("oracle parameter TallyStrategies contains not whitelisted denom %q", strategy.Denom)
+		}
+		if strategyCheck[strategy.Denom] {
+			return fmt.Errorf("oracle parameter TallyStrategies contains duplicated denom %s", strategy.Denom)
+		}
+		strategyCheck[strategy.Denom] = true
+
+		if _, err := strategy.TallyStrategy(); err != nil {
+			return fmt.Errorf("oracle parameter TallyStrategies is invalid: %s", err)
+		}
+	}
 	return nil
 }
 
@@ -102,6 +205,15 @@ func (params *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{Key: ParamStoreKeyVotesWindow, Value: &params.VotesWindow},
 		{Key: ParamStoreKeyMinValidVotesPerWindow, Value: &params.MinValidVotesPerWindow},
 		{Key: ParamStoreKeySlashFraction, Value: &params.SlashFraction},
+		{Key: ParamStoreKeyWhitelist, Value: &params.Whitelist},
+		{Key: ParamStoreKeyPriceHistoryRetention, Value: &params.PriceHistoryRetention},
+		{Key: ParamStoreKeyAbstainBudgetPerWindow, Value: &params.AbstainBudgetPerWindow},
+		{Key: ParamStoreKeyReferenceDenom, Value: &params.ReferenceDenom},
+		{Key: ParamStoreKeyJailDuration, Value: &params.JailDuration},
+		{Key: ParamStoreKeyTallyStrategies, Value: &params.TallyStrategies},
+		{Key: ParamStoreKeyMaxPriceChange, Value: &params.MaxPriceChange},
+		{Key: ParamStoreKeyPriceBandMode, Value: &params.PriceBandMode},
+		{Key: ParamStoreKeyMaxUnsettledPeriods, Value: &params.MaxUnsettledPeriods},
 	}
 }
 
@@ -115,6 +227,17 @@ func (params Params) String() string {
 	VotesWindow:              %d
 	MinValidVotesPerWindow:   %s
 	SlashFraction:            %s
+	Whitelist:                %s
+	PriceHistoryRetention:    %d
+	AbstainBudgetPerWindow:   %s
+	ReferenceDenom:           %s
+	JailDuration:             %s
+	TallyStrategies:          %s
+	MaxPriceChange:           %s
+	PriceBandMode:            %s
+	MaxUnsettledPeriods:      %d
 	`, params.VotePeriod, params.VoteThreshold, params.RewardBand, params.RewardFraction,
-		params.VotesWindow, params.MinValidVotesPerWindow, params.SlashFraction)
+		params.VotesWindow, params.MinValidVotesPerWindow, params.SlashFraction, strings.Join(params.Whitelist, ", "),
+		params.PriceHistoryRetention, params.AbstainBudgetPerWindow, params.ReferenceDenom, params.JailDuration, params.TallyStrategies,
+		params.MaxPriceChange, params.PriceBandMode, params.MaxUnsettledPeriods)
 }
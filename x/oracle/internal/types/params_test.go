@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
 )
 
 func TestParamsEqual(t *testing.T) {
@@ -47,4 +49,111 @@ func TestParamsEqual(t *testing.T) {
 	p6.SlashFraction = sdk.NewDecWithPrec(-1, 2)
 	err = p6.Validate()
 	require.Error(t, err)
+
+	// duplicated whitelist denom
+	p7 := DefaultParams()
+	p7.Whitelist = DenomList{core.MicroKRWDenom, core.MicroKRWDenom}
+	err = p7.Validate()
+	require.Error(t, err)
+
+	// luna in whitelist
+	p8 := DefaultParams()
+	p8.Whitelist = DenomList{core.MicroLunaDenom}
+	err = p8.Validate()
+	require.Error(t, err)
+
+	// empty denom in whitelist
+	p9 := DefaultParams()
+	p9.Whitelist = DenomList{""}
+	err = p9.Validate()
+	require.Error(t, err)
+
+	// zero price history retention
+	p10 := DefaultParams()
+	p10.PriceHistoryRetention = 0
+	err = p10.Validate()
+	require.Error(t, err)
+
+	// abstain budget bigger than 1
+	p11 := DefaultParams()
+	p11.AbstainBudgetPerWindow = sdk.NewDecWithPrec(11, 1)
+	err = p11.Validate()
+	require.Error(t, err)
+
+	// negative abstain budget
+	p12 := DefaultParams()
+	p12.AbstainBudgetPerWindow = sdk.NewDecWithPrec(-1, 1)
+	err = p12.Validate()
+	require.Error(t, err)
+
+	// reference denom not whitelisted
+	p13 := DefaultParams()
+	p13.Whitelist = DenomList{core.MicroSDRDenom}
+	p13.ReferenceDenom = core.MicroCNYDenom
+	err = p13.Validate()
+	require.Error(t, err)
+
+	// whitelisted reference denom
+	p14 := DefaultParams()
+	p14.ReferenceDenom = core.MicroSDRDenom
+	err = p14.Validate()
+	require.NoError(t, err)
+
+	// zero jail duration
+	p15 := DefaultParams()
+	p15.JailDuration = 0
+	err = p15.Validate()
+	require.Error(t, err)
+
+	// tally strategy of a denom not whitelisted
+	p16 := DefaultParams()
+	p16.Whitelist = DenomList{core.MicroSDRDenom}
+	p16.TallyStrategies = DenomTallyStrategies{NewDenomTallyStrategy(core.MicroCNYDenom, TallyStrategyWeightedMedian, sdk.ZeroDec())}
+	err = p16.Validate()
+	require.Error(t, err)
+
+	// invalid tally strategy
+	p17 := DefaultParams()
+	p17.TallyStrategies = DenomTallyStrategies{NewDenomTallyStrategy(core.MicroSDRDenom, TallyStrategyTrimmedMean, sdk.OneDec())}
+	err = p17.Validate()
+	require.Error(t, err)
+
+	// duplicated tally strategies
+	p18 := DefaultParams()
+	p18.TallyStrategies = DenomTallyStrategies{
+		NewDenomTallyStrategy(core.MicroSDRDenom, TallyStrategyWeightedMedian, sdk.ZeroDec()),
+		NewDenomTallyStrategy(core.MicroSDRDenom, TallyStrategyFilteredMedian, sdk.OneDec()),
+	}
+	err = p18.Validate()
+	require.Error(t, err)
+
+	// valid tally strategy
+	p19 := DefaultParams()
+	p19.TallyStrategies = DenomTallyStrategies{NewDenomTallyStrategy(core.MicroSDRDenom, TallyStrategyFilteredMedian, sdk.OneDec())}
+	err = p19.Validate()
+	require.NoError(t, err)
+
+	// negative max price change
+	p20 := DefaultParams()
+	p20.MaxPriceChange = sdk.NewDecWithPrec(-1, 1)
+	err = p20.Validate()
+	require.Error(t, err)
+
+	// max price change of 100%
+	p21 := DefaultParams()
+	p21.MaxPriceChange = sdk.OneDec()
+	err = p21.Validate()
+	require.Error(t, err)
+
+	// unknown price band mode
+	p22 := DefaultParams()
+	p22.PriceBandMode = "halt"
+	err = p22.Validate()
+	require.Error(t, err)
+
+	// price never accepted while out of the band
+	p23 := DefaultParams()
+	p23.MaxUnsettledPeriods = 0
+	err = p23.Validate()
+	require.Error(t, err)
 }
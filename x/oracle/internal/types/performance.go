@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DenomDeviation - average absolute deviation of the votes of a validator from the weighted median
+// of the ballots of a denom
+type DenomDeviation struct {
+	Denom            string  `json:"denom" yaml:"denom"`
+	Votes            int64   `json:"votes" yaml:"votes"`                         // number of tallied votes the average is taken over
+	AverageDeviation sdk.Dec `json:"average_deviation" yaml:"average_deviation"` // average of |vote - weighted median|
+}
+
+// NewDenomDeviation creates a DenomDeviation instance
+func NewDenomDeviation(denom string, votes int64, averageDeviation sdk.Dec) DenomDeviation {
+	return DenomDeviation{
+		Denom:            denom,
+		Votes:            votes,
+		AverageDeviation: averageDeviation,
+	}
+}
+
+// String implements fmt.Stringer
+func (dd DenomDeviation) String() string {
+	return fmt.Sprintf("%s: %s (%d votes)", dd.Denom, dd.AverageDeviation, dd.Votes)
+}
+
+// ValidatorPerformance - long-term oracle performance statistics of a validator
+type ValidatorPerformance struct {
+	Address     sdk.ValAddress   `json:"address" yaml:"address"`
+	BallotsWon  int64            `json:"ballots_won" yaml:"ballots_won"`   // votes rewarded in tallied ballots
+	BallotsLost int64            `json:"ballots_lost" yaml:"ballots_lost"` // votes outside of the reward spread in tallied ballots
+	Abstentions int64            `json:"abstentions" yaml:"abstentions"`   // abstain votes
+	Deviations  []DenomDeviation `json:"deviations" yaml:"deviations"`     // per denom, in the order first tallied
+	Rewards     sdk.Coins        `json:"rewards" yaml:"rewards"`           // rewards earned as a ballot winner
+}
+
+// NewValidatorPerformance creates a ValidatorPerformance instance with empty statistics
+func NewValidatorPerformance(valAddr sdk.ValAddress) ValidatorPerformance {
+	return ValidatorPerformance{
+		Address:    valAddr,
+		Deviations: []DenomDeviation{},
+		Rewards:    sdk.NewCoins(),
+	}
+}
+
+// AddDeviation folds the absolute deviation of a tallied vote into the average of the denom
+func (vp *ValidatorPerformance) AddDeviation(denom string, deviation sdk.Dec) {
+	for i, dd := range vp.Deviations {
+		if dd.Denom == denom {
+			votes := dd.Votes + 1
+			average := dd.AverageDeviation.MulInt64(dd.Votes).Add(deviation).QuoInt64(votes)
+			vp.Deviations[i] = NewDenomDeviation(denom, votes, average)
+			return
+		}
+	}
+
+	vp.Deviations = append(vp.Deviations, NewDenomDeviation(denom, 1, deviation))
+}
+
+// String implements fmt.Stringer
+func (vp ValidatorPerformance) String() string {
+	deviations := make([]string, len(vp.Deviations))
+	for i, dd := range vp.Deviations {
+		deviations[i] = dd.String()
+	}
+
+	return fmt.Sprintf(`Validator Oracle Performance:
+  Address:       %s
+  Ballots Won:   %d
+  Ballots Lost:  %d
+  Abstentions:   %d
+  Deviations:    %s
+  Rewards:       %s`,
+		vp.Address, vp.BallotsWon, vp.BallotsLost, vp.Abstentions,
+		strings.Join(deviations, ", "), vp.Rewards)
+}
+
+// ValidatorPerformances is a collection of ValidatorPerformance
+type ValidatorPerformances []ValidatorPerformance
+
+// String implements fmt.Stringer
+func (vps ValidatorPerformances) String() (out string) {
+	for _, vp := range vps {
+		out += vp.String() + "\n"
+	}
+	return
+}
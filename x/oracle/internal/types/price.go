@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceInfo - struct to store the consensus price of Luna in a denom, with the height and time it was tallied
+type PriceInfo struct {
+	Price  sdk.Dec   `json:"price"`
+	Height int64     `json:"height"`
+	Time   time.Time `json:"time"`
+}
+
+// NewPriceInfo creates a PriceInfo instance
+func NewPriceInfo(price sdk.Dec, height int64, time time.Time) PriceInfo {
+	return PriceInfo{
+		Price:  price,
+		Height: height,
+		Time:   time,
+	}
+}
+
+// String implements fmt.Stringer
+func (pi PriceInfo) String() string {
+	return fmt.Sprintf(`PriceInfo
+	Price:    %s,
+	Height:   %d,
+	Time:     %s`,
+		pi.Price, pi.Height, pi.Time)
+}
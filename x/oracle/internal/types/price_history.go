@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceSnapshot - struct to store the consensus price of Luna in a denom at the tally height
+type PriceSnapshot struct {
+	Height int64   `json:"height"`
+	Price  sdk.Dec `json:"price"`
+}
+
+// NewPriceSnapshot creates a PriceSnapshot instance
+func NewPriceSnapshot(height int64, price sdk.Dec) PriceSnapshot {
+	return PriceSnapshot{
+		Height: height,
+		Price:  price,
+	}
+}
+
+// String implements fmt.Stringer
+func (ps PriceSnapshot) String() string {
+	return fmt.Sprintf(`PriceSnapshot
+	Height:    %d,
+	Price:    %s`,
+		ps.Height, ps.Price)
+}
+
+// PriceSnapshots is a collection of PriceSnapshot
+type PriceSnapshots []PriceSnapshot
+
+func (pss PriceSnapshots) String() (out string) {
+	for _, val := range pss {
+		out += val.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
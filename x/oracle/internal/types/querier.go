@@ -13,6 +13,13 @@ const (
 	QueryFeederDelegation = "feederDelegation"
 	QueryVotingInfo       = "signingInfo"
 	QueryVotingInfos      = "signingInfos"
+	QueryAggregatePrevote = "aggregatePrevote"
+	QueryAggregateVote    = "aggregateVote"
+	QueryHistoricalPrice  = "historicalPrice"
+	QueryTWAP             = "twap"
+	QueryPerformance      = "performance"
+	QueryPerformances     = "performances"
+	QueryBallotPreview    = "ballotPreview"
 )
 
 // QueryPriceParams defines the params for the following queries:
@@ -76,3 +83,65 @@ type QueryVotingInfosParams struct {
 func NewQueryVotingInfosParams(page, limit int) QueryVotingInfosParams {
 	return QueryVotingInfosParams{page, limit}
 }
+
+// QueryAggregatePrevoteParams defines the params for the following queries:
+// - 'custom/oracle/aggregatePrevote'
+type QueryAggregatePrevoteParams struct {
+	Validator sdk.ValAddress
+}
+
+func NewQueryAggregatePrevoteParams(validator sdk.ValAddress) QueryAggregatePrevoteParams {
+	return QueryAggregatePrevoteParams{validator}
+}
+
+// QueryAggregateVoteParams defines the params for the following queries:
+// - 'custom/oracle/aggregateVote'
+type QueryAggregateVoteParams struct {
+	Validator sdk.ValAddress
+}
+
+func NewQueryAggregateVoteParams(validator sdk.ValAddress) QueryAggregateVoteParams {
+	return QueryAggregateVoteParams{validator}
+}
+
+// QueryHistoricalPriceParams defines the params for the following queries:
+// - 'custom/oracle/historicalPrice'
+type QueryHistoricalPriceParams struct {
+	Denom  string
+	Height int64
+}
+
+func NewQueryHistoricalPriceParams(denom string, height int64) QueryHistoricalPriceParams {
+	return QueryHistoricalPriceParams{denom, height}
+}
+
+// QueryTWAPParams defines the params for the following queries:
+// - 'custom/oracle/twap'
+type QueryTWAPParams struct {
+	Denom   string
+	Periods int64
+}
+
+func NewQueryTWAPParams(denom string, periods int64) QueryTWAPParams {
+	return QueryTWAPParams{denom, periods}
+}
+
+// QueryPerformanceParams defines the params for the following queries:
+// - 'custom/oracle/performance'
+type QueryPerformanceParams struct {
+	ValAddress sdk.ValAddress
+}
+
+func NewQueryPerformanceParams(valAddr sdk.ValAddress) QueryPerformanceParams {
+	return QueryPerformanceParams{valAddr}
+}
+
+// QueryPerformancesParams defines the params for the following queries:
+// - 'custom/oracle/performances'
+type QueryPerformancesParams struct {
+	Page, Limit int
+}
+
+func NewQueryPerformancesParams(page, limit int) QueryPerformancesParams {
+	return QueryPerformancesParams{page, limit}
+}
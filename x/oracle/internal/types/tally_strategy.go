@@ -0,0 +1,103 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Tally strategy names
+const (
+	TallyStrategyWeightedMedian = "weighted_median"
+	TallyStrategyTrimmedMean    = "trimmed_mean"
+	TallyStrategyFilteredMedian = "filtered_median"
+)
+
+// TallyStrategy computes the consensus price of a ballot
+type TallyStrategy interface {
+	Price(ctx sdk.Context, pb PriceBallot, sk StakingKeeper) sdk.Dec
+}
+
+// WeightedMedianStrategy tallies a ballot to its median weighted by the power of the votes
+type WeightedMedianStrategy struct{}
+
+// Price implements TallyStrategy
+func (WeightedMedianStrategy) Price(ctx sdk.Context, pb PriceBallot, sk StakingKeeper) sdk.Dec {
+	return pb.WeightedMedian(ctx, sk)
+}
+
+// TrimmedMeanStrategy tallies a ballot to its mean weighted by the power of the votes,
+// after trimming TrimFraction of the total power from each end of the ballot
+type TrimmedMeanStrategy struct {
+	TrimFraction sdk.Dec
+}
+
+// Price implements TallyStrategy
+func (s TrimmedMeanStrategy) Price(ctx sdk.Context, pb PriceBallot, sk StakingKeeper) sdk.Dec {
+	return pb.WeightedTrimmedMean(ctx, sk, s.TrimFraction)
+}
+
+// FilteredMedianStrategy tallies a ballot to its weighted median, after dropping the votes
+// more than K standard deviations away from the weighted median
+type FilteredMedianStrategy struct {
+	K sdk.Dec
+}
+
+// Price implements TallyStrategy
+func (s FilteredMedianStrategy) Price(ctx sdk.Context, pb PriceBallot, sk StakingKeeper) sdk.Dec {
+	return pb.FilteredWeightedMedian(ctx, sk, s.K)
+}
+
+// DenomTallyStrategy - tally strategy applied to the ballots of a denom
+type DenomTallyStrategy struct {
+	Denom    string  `json:"denom" yaml:"denom"`
+	Strategy string  `json:"strategy" yaml:"strategy"`
+	Param    sdk.Dec `json:"param" yaml:"param"` // fraction of the power trimmed from each end for trimmed_mean; K standard deviations for filtered_median
+}
+
+// NewDenomTallyStrategy creates a DenomTallyStrategy instance
+func NewDenomTallyStrategy(denom string, strategy string, param sdk.Dec) DenomTallyStrategy {
+	return DenomTallyStrategy{
+		Denom:    denom,
+		Strategy: strategy,
+		Param:    param,
+	}
+}
+
+// TallyStrategy returns the TallyStrategy described by the strategy name and parameter
+func (dts DenomTallyStrategy) TallyStrategy() (TallyStrategy, error) {
+	switch dts.Strategy {
+	case TallyStrategyWeightedMedian:
+		return WeightedMedianStrategy{}, nil
+	case TallyStrategyTrimmedMean:
+		if dts.Param.IsNil() || dts.Param.IsNegative() || dts.Param.GTE(sdk.NewDecWithPrec(5, 1)) {
+			return nil, fmt.Errorf("trim fraction of %s should be at least zero and less than one half, is %s", dts.Denom, dts.Param)
+		}
+		return TrimmedMeanStrategy{TrimFraction: dts.Param}, nil
+	case TallyStrategyFilteredMedian:
+		if dts.Param.IsNil() || !dts.Param.IsPositive() {
+			return nil, fmt.Errorf("standard deviation multiplier of %s should be positive, is %s", dts.Denom, dts.Param)
+		}
+		return FilteredMedianStrategy{K: dts.Param}, nil
+	default:
+		return nil, fmt.Errorf("unknown tally strategy %q for %s", dts.Strategy, dts.Denom)
+	}
+}
+
+// String implements fmt.Stringer
+func (dts DenomTallyStrategy) String() string {
+	return fmt.Sprintf("%s:%s(%s)", dts.Denom, dts.Strategy, dts.Param)
+}
+
+// DenomTallyStrategies is a collection of DenomTallyStrategy
+type DenomTallyStrategies []DenomTallyStrategy
+
+// String implements fmt.Stringer
+func (dtss DenomTallyStrategies) String() string {
+	strs := make([]string, len(dtss))
+	for i, dts := range dtss {
+		strs[i] = dts.String()
+	}
+	return strings.Join(strs, ", ")
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	core "github.com/terra-project/core/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTallyStrategies(t *testing.T) {
+	ctx := sdk.NewContext(nil, abci.Header{}, false, nil)
+
+	weightedMedian := NewDenomTallyStrategy(core.MicroSDRDenom, TallyStrategyWeightedMedian, sdk.ZeroDec())
+	trimmedMean := func(trimFraction sdk.Dec) DenomTallyStrategy {
+		return NewDenomTallyStrategy(core.MicroSDRDenom, TallyStrategyTrimmedMean, trimFraction)
+	}
+	filteredMedian := func(k sdk.Dec) DenomTallyStrategy {
+		return NewDenomTallyStrategy(core.MicroSDRDenom, TallyStrategyFilteredMedian, k)
+	}
+
+	tests := []struct {
+		prices   []int64
+		powers   []int64
+		strategy DenomTallyStrategy
+		price    sdk.Dec
+	}{
+		// equal power, one outlier above
+		{[]int64{1, 2, 3, 4, 1000}, []int64{1, 1, 1, 1, 1}, weightedMedian, sdk.NewDec(2)},
+		{[]int64{1, 2, 3, 4, 1000}, []int64{1, 1, 1, 1, 1}, trimmedMean(sdk.ZeroDec()), sdk.NewDec(202)},
+		{[]int64{1, 2, 3, 4, 1000}, []int64{1, 1, 1, 1, 1}, trimmedMean(sdk.NewDecWithPrec(2, 1)), sdk.NewDec(3)},
+		{[]int64{1, 2, 3, 4, 1000}, []int64{1, 1, 1, 1, 1}, filteredMedian(sdk.OneDec()), sdk.NewDec(2)},
+
+		// heavy outlier above; filtering it out moves the median down
+		{[]int64{10, 11, 12, 100}, []int64{1, 1, 1, 2}, weightedMedian, sdk.NewDec(11)},
+		{[]int64{10, 11, 12, 100}, []int64{1, 1, 1, 2}, filteredMedian(sdk.OneDec()), sdk.NewDec(10)},
+		{[]int64{10, 11, 12, 100}, []int64{1, 1, 1, 2}, filteredMedian(sdk.NewDec(3)), sdk.NewDec(11)},
+
+		// outlier below against a heavy vote above; filtering it out moves the median up
+		{[]int64{1, 90, 91, 92}, []int64{1, 1, 1, 2}, weightedMedian, sdk.NewDec(90)},
+		{[]int64{1, 90, 91, 92}, []int64{1, 1, 1, 2}, filteredMedian(sdk.OneDec()), sdk.NewDec(91)},
+
+		// unequal power; the trim cuts through the power of the heavy vote
+		{[]int64{1, 2, 3, 4}, []int64{1, 1, 1, 5}, trimmedMean(sdk.ZeroDec()), sdk.NewDecWithPrec(325, 2)},
+		{[]int64{1, 2, 3, 4}, []int64{1, 1, 1, 5}, trimmedMean(sdk.NewDecWithPrec(25, 2)), sdk.NewDecWithPrec(375, 2)},
+		{[]int64{1, 2, 3, 4}, []int64{5, 1, 1, 1}, trimmedMean(sdk.NewDecWithPrec(25, 2)), sdk.NewDecWithPrec(125, 2)},
+
+		// empty ballot
+		{[]int64{}, []int64{}, weightedMedian, sdk.ZeroDec()},
+		{[]int64{}, []int64{}, trimmedMean(sdk.NewDecWithPrec(2, 1)), sdk.ZeroDec()},
+		{[]int64{}, []int64{}, filteredMedian(sdk.OneDec()), sdk.ZeroDec()},
+
+		// single vote
+		{[]int64{5}, []int64{3}, weightedMedian, sdk.NewDec(5)},
+		{[]int64{5}, []int64{3}, trimmedMean(sdk.NewDecWithPrec(4, 1)), sdk.NewDec(5)},
+		{[]int64{5}, []int64{3}, filteredMedian(sdk.OneDec()), sdk.NewDec(5)},
+
+		// zero standard deviation; no vote is filtered out
+		{[]int64{7, 7, 7}, []int64{1, 2, 3}, weightedMedian, sdk.NewDec(7)},
+		{[]int64{7, 7, 7}, []int64{1, 2, 3}, trimmedMean(sdk.NewDecWithPrec(2, 1)), sdk.NewDec(7)},
+		{[]int64{7, 7, 7}, []int64{1, 2, 3}, filteredMedian(sdk.OneDec()), sdk.NewDec(7)},
+	}
+
+	for i, tc := range tests {
+		var mockValset []MockValidator
+		pb := PriceBallot{}
+		for j, price := range tc.prices {
+			valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+			mockValset = append(mockValset, NewMockValidator(valAddr, tc.powers[j]))
+			pb = append(pb, NewPriceVote(sdk.NewDec(price), core.MicroSDRDenom, valAddr))
+		}
+		sk := NewDummyStakingKeeper(mockValset)
+
+		strategy, err := tc.strategy.TallyStrategy()
+		require.NoError(t, err, "test: %v", i)
+		require.Equal(t, tc.price, strategy.Price(ctx, pb, sk), "test: %v", i)
+	}
+}
+
+func TestDenomTallyStrategy(t *testing.T) {
+	tests := []struct {
+		strategy   DenomTallyStrategy
+		expectPass bool
+	}{
+		{NewDenomTallyStrategy(core.MicroSDRDenom, TallyStrategyWeightedMedian, sdk.Dec{}), true},
+		{NewDenomTallyStrategy(core.MicroSDRDenom, TallyStrategyTrimmedMean, sdk.NewDecWithPrec(49, 2)), true},
+		{NewDenomTallyStrategy(core.MicroSDRDenom, TallyStrategyTrimmedMean, sdk.NewDecWithPrec(5, 1)), false},
+		{NewDenomTallyStrategy(core.MicroSDRDenom, TallyStrategyTrimmedMean, sdk.NewDecWithPrec(-1, 1)), false},
+		{NewDenomTallyStrategy(core.MicroSDRDenom, TallyStrategyFilteredMedian, sdk.NewDec(2)), true},
+		{NewDenomTallyStrategy(core.MicroSDRDenom, TallyStrategyFilteredMedian, sdk.ZeroDec()), false},
+		{NewDenomTallyStrategy(core.MicroSDRDenom, "mean", sdk.ZeroDec()), false},
+	}
+
+	for i, tc := range tests {
+		_, err := tc.strategy.TallyStrategy()
+		if tc.expectPass {
+			require.NoError(t, err, "test: %v", i)
+		} else {
+			require.Error(t, err, "test: %v", i)
+		}
+	}
+}
@@ -87,6 +87,17 @@ func (DummyStakingKeeper) IterateBondedValidatorsByPower(_ sdk.Context, _ func(_
 // Slash nolint
 func (DummyStakingKeeper) Slash(_ sdk.Context, _ sdk.ConsAddress, _, _ int64, _ sdk.Dec) {}
 
+// Jail nolint
+func (DummyStakingKeeper) Jail(_ sdk.Context, _ sdk.ConsAddress) {}
+
+// Unjail nolint
+func (DummyStakingKeeper) Unjail(_ sdk.Context, _ sdk.ConsAddress) {}
+
+// Delegation nolint
+func (DummyStakingKeeper) Delegation(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) exported.DelegationI {
+	return nil
+}
+
 type MockValidator struct {
 	power    int64
 	operator sdk.ValAddress
@@ -70,6 +70,11 @@ func NewPriceVote(price sdk.Dec, denom string, voter sdk.ValAddress) PriceVote {
 	}
 }
 
+// IsAbstain returns true if the vote reports no price for the denom
+func (pv PriceVote) IsAbstain() bool {
+	return pv.Price.IsZero()
+}
+
 func (pv PriceVote) getPower(ctx sdk.Context, sk StakingKeeper) int64 {
 	validator := sk.Validator(ctx, pv.Voter)
 	if validator == nil {
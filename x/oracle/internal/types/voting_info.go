@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -12,19 +13,25 @@ type VotingInfo struct {
 	StartHeight        int64          `json:"start_height" yaml:"start_height"`                 // height at which validator was first a candidate OR was unjailed
 	IndexOffset        int64          `json:"index_offset" yaml:"index_offset"`                 // index offset into signed block bit array
 	MissedVotesCounter int64          `json:"missed_votes_counter" yaml:"missed_votes_counter"` // missed blocks counter (to avoid scanning the array every time)
+
+	AbstainVotesCounter int64 `json:"abstain_votes_counter" yaml:"abstain_votes_counter"` // abstains counted as valid votes in the current votes window
+
+	Jailed      bool      `json:"jailed" yaml:"jailed"`             // whether the validator is jailed by the oracle
+	JailedUntil time.Time `json:"jailed_until" yaml:"jailed_until"` // timestamp until which the validator is jailed by the oracle
 }
 
 // NewVotingInfo creates a new NewVotingInfo instance
 func NewVotingInfo(
 	valAddr sdk.ValAddress, startHeight,
-	indexOffset, missedvotesCounter int64,
+	indexOffset, missedvotesCounter, abstainVotesCounter int64,
 ) VotingInfo {
 
 	return VotingInfo{
-		Address:            valAddr,
-		StartHeight:        startHeight,
-		IndexOffset:        indexOffset,
-		MissedVotesCounter: missedvotesCounter,
+		Address:             valAddr,
+		StartHeight:         startHeight,
+		IndexOffset:         indexOffset,
+		MissedVotesCounter:  missedvotesCounter,
+		AbstainVotesCounter: abstainVotesCounter,
 	}
 }
 
@@ -34,7 +41,11 @@ func (i VotingInfo) String() string {
   Address:               %s
   Start Height:          %d
   Index Offset:          %d
-  Missed Votes Counter: %d`,
+  Missed Votes Counter: %d
+  Abstain Votes Counter: %d
+  Jailed:                %v
+  Jailed Until:          %v`,
 		i.Address, i.StartHeight,
-		i.IndexOffset, i.MissedVotesCounter)
+		i.IndexOffset, i.MissedVotesCounter, i.AbstainVotesCounter,
+		i.Jailed, i.JailedUntil)
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 
@@ -53,6 +54,7 @@ func getMockApp(t *testing.T, numGenAccs int, genState GenesisState, genAccs []a
 	keyDistr := sdk.NewKVStoreKey(distr.StoreKey)
 	keyOracle := sdk.NewKVStoreKey(StoreKey)
 	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keySlashing := sdk.NewKVStoreKey(slashing.StoreKey)
 
 	blackListAddrs := map[string]bool{
 		auth.FeeCollectorName:     true,
@@ -77,7 +79,9 @@ func getMockApp(t *testing.T, numGenAccs int, genState GenesisState, genAccs []a
 	stakingKeeper := staking.NewKeeper(mApp.Cdc, keyStaking, tKeyStaking, supplyKeeper, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
 	distrKeeper := distr.NewKeeper(mApp.Cdc, keyDistr, pk.Subspace(distr.DefaultParamspace), stakingKeeper, supplyKeeper, distr.DefaultCodespace, auth.FeeCollectorName, blackListAddrs)
 
-	keeper := NewKeeper(mApp.Cdc, keyOracle, pk.Subspace(DefaultParamspace), distrKeeper, stakingKeeper, supplyKeeper, distr.ModuleName, DefaultCodespace)
+	slashingKeeper := slashing.NewKeeper(mApp.Cdc, keySlashing, stakingKeeper, pk.Subspace(slashing.DefaultParamspace), slashing.DefaultCodespace)
+
+	keeper := NewKeeper(mApp.Cdc, keyOracle, pk.Subspace(DefaultParamspace), distrKeeper, stakingKeeper, slashingKeeper, supplyKeeper, distr.ModuleName, DefaultCodespace)
 
 	mApp.Router().AddRoute(RouterKey, NewHandler(keeper))
 	mApp.QueryRouter().AddRoute(QuerierRoute, NewQuerier(keeper))
@@ -86,7 +90,7 @@ func getMockApp(t *testing.T, numGenAccs int, genState GenesisState, genAccs []a
 	mApp.SetEndBlocker(getEndBlocker(keeper, stakingKeeper))
 	mApp.SetInitChainer(getInitChainer(mApp, keeper, stakingKeeper, supplyKeeper, genAccs, genState))
 
-	require.NoError(t, mApp.CompleteSetup(keyStaking, tKeyStaking, keyOracle, keySupply, keyDistr))
+	require.NoError(t, mApp.CompleteSetup(keyStaking, tKeyStaking, keyOracle, keySupply, keyDistr, keySlashing))
 
 	var (
 		addrs    []sdk.AccAddress
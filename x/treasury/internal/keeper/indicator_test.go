@@ -49,8 +49,8 @@ func TestSeigniorageRewardsForEpoch(t *testing.T) {
 	input.TreasuryKeeper.UpdateIssuance(input.Ctx)
 
 	// Set random prices
-	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, lnasdrRate)
 	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, lnasdrRate)
 
 	// Add seigniorage
 	supply = supply.SetTotal(sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, sdk.ZeroInt())))
@@ -15,8 +15,6 @@ import (
 func TestSettle(t *testing.T) {
 	input := CreateTestInput(t)
 
-	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, sdk.OneDec())
-
 	issuance := sdk.NewInt(rand.Int63() + 1)
 	supply := input.SupplyKeeper.GetSupply(input.Ctx)
 	supply = supply.SetTotal(sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, issuance)))
@@ -24,6 +22,7 @@ func TestSettle(t *testing.T) {
 	input.TreasuryKeeper.UpdateIssuance(input.Ctx)
 
 	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch)
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, sdk.OneDec())
 	supply = supply.SetTotal(sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, sdk.ZeroInt())))
 	input.SupplyKeeper.SetSupply(input.Ctx, supply)
 
@@ -43,3 +42,31 @@ func TestSettle(t *testing.T) {
 	require.Equal(t, oracleRewardAmt, oracleAcc.GetCoins().AmountOf(core.MicroSDRDenom))
 	require.Equal(t, leftAmt, feePool.CommunityPool.AmountOf(core.MicroSDRDenom).TruncateInt())
 }
+
+func TestSettleStalePrice(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// the price is stale by the end of the epoch
+	input.OracleKeeper.SetLunaPrice(input.Ctx, core.MicroSDRDenom, sdk.OneDec())
+
+	issuance := sdk.NewInt(rand.Int63() + 1)
+	supply := input.SupplyKeeper.GetSupply(input.Ctx)
+	supply = supply.SetTotal(sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, issuance)))
+	input.SupplyKeeper.SetSupply(input.Ctx, supply)
+	input.TreasuryKeeper.UpdateIssuance(input.Ctx)
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerEpoch)
+	supply = supply.SetTotal(sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, sdk.ZeroInt())))
+	input.SupplyKeeper.SetSupply(input.Ctx, supply)
+	require.Equal(t, issuance, input.TreasuryKeeper.PeekEpochSeigniorage(input.Ctx, 1))
+
+	// no seigniorage is minted at a stale price
+	input.TreasuryKeeper.SettleSeigniorage(input.Ctx)
+	oracleAcc := input.SupplyKeeper.GetModuleAccount(input.Ctx, input.TreasuryKeeper.oracleModuleName)
+	require.True(t, oracleAcc.GetCoins().Empty())
+	require.True(t, input.DistrKeeper.GetFeePool(input.Ctx).CommunityPool.AmountOf(core.MicroSDRDenom).IsZero())
+	require.True(t, input.SupplyKeeper.GetSupply(input.Ctx).GetTotal().AmountOf(core.MicroSDRDenom).IsZero())
+
+	// nor counted in the seigniorage rewards
+	require.True(t, SeigniorageRewardsForEpoch(input.Ctx, input.TreasuryKeeper, 1).IsZero())
+}
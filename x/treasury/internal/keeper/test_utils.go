@@ -26,6 +26,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 )
@@ -92,6 +93,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	tKeyStaking := sdk.NewKVStoreKey(staking.TStoreKey)
 	keyDistr := sdk.NewKVStoreKey(distr.StoreKey)
 	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keySlashing := sdk.NewKVStoreKey(slashing.StoreKey)
 	keyMarket := sdk.NewKVStoreKey(market.StoreKey)
 	keyTreasury := sdk.NewKVStoreKey(types.StoreKey)
 
@@ -108,6 +110,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	ms.MountStoreWithDB(tKeyStaking, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyDistr, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySlashing, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyMarket, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyTreasury, sdk.StoreTypeIAVL, db)
 
@@ -160,17 +163,25 @@ func CreateTestInput(t *testing.T) TestInput {
 	distrKeeper.SetBaseProposerReward(ctx, sdk.NewDecWithPrec(1, 2))
 	distrKeeper.SetBonusProposerReward(ctx, sdk.NewDecWithPrec(4, 2))
 
+	slashingKeeper := slashing.NewKeeper(
+		cdc,
+		keySlashing, stakingKeeper,
+		paramsKeeper.Subspace(slashing.DefaultParamspace),
+		slashing.DefaultCodespace,
+	)
+
 	oracleKeeper := oracle.NewKeeper(
 		cdc,
 		keyOracle, paramsKeeper.Subspace(oracle.DefaultParamspace),
-		distrKeeper, stakingKeeper, supplyKeeper, distr.ModuleName,
+		distrKeeper, stakingKeeper, slashingKeeper, supplyKeeper, distr.ModuleName,
 		oracle.DefaultCodespace,
 	)
 
 	marketKeeper := market.NewKeeper(
 		cdc,
 		keyMarket, paramsKeeper.Subspace(market.DefaultParamspace),
-		oracleKeeper, supplyKeeper, market.DefaultCodespace,
+		oracleKeeper, supplyKeeper, distrKeeper,
+		oracle.ModuleName, distr.ModuleName, market.DefaultCodespace,
 	)
 
 	treasuryKeeper := NewKeeper(
@@ -181,6 +192,8 @@ func CreateTestInput(t *testing.T) TestInput {
 		types.DefaultCodespace,
 	)
 
+	oracleKeeper.SetParams(ctx, oracle.DefaultParams())
+	marketKeeper.SetParams(ctx, market.DefaultParams())
 	treasuryKeeper.SetParams(ctx, types.DefaultParams())
 
 	feeCollectorAcc := supply.NewEmptyModuleAccount(auth.FeeCollectorName)